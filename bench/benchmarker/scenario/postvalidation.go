@@ -6,16 +6,17 @@ import (
 	"time"
 
 	"github.com/isucon/isucon14/bench/benchmarker/webapp"
+	"github.com/isucon/isucon14/bench/benchmarker/world"
 )
 
-func PostValidation(ctx context.Context, target string, addr string) error {
+func PostValidation(ctx context.Context, target string, addr string, features world.Features) error {
 	clientConfig := webapp.ClientConfig{
 		TargetBaseURL:         target,
 		TargetAddr:            addr,
 		ClientIdleConnTimeout: 10 * time.Second,
 	}
 
-	if err := validateInitialData(ctx, clientConfig); err != nil {
+	if err := validateInitialData(ctx, clientConfig, features); err != nil {
 		slog.String("初期データのバリデーションに失敗", err.Error())
 		return err
 	}
@@ -12,7 +12,9 @@ import (
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/isucon/isucon14/bench/benchmarker/webapp"
 	"github.com/isucon/isucon14/bench/benchmarker/webapp/api"
+	"github.com/isucon/isucon14/bench/benchmarker/world"
 	"github.com/isucon/isucon14/bench/benchrun"
+	"github.com/isucon/isucon14/bench/internal/concurrent"
 )
 
 func (s *Scenario) validateFrontendFiles(ctx context.Context) error {
@@ -75,19 +77,24 @@ func (s *Scenario) prevalidation(ctx context.Context, client *webapp.Client) err
 		ClientIdleConnTimeout: 10 * time.Second,
 	}
 
-	if err := validateInitialData(ctx, clientConfig); err != nil {
+	if err := validateInitialData(ctx, clientConfig, s.world.Features); err != nil {
 		s.contestantLogger.Error("初期データのチェックに失敗しました", slog.String("error", err.Error()))
 		return err
 	}
 
+	if err := s.validateCancelFlow(ctx, clientConfig); err != nil {
+		s.contestantLogger.Error("ライドのキャンセルのチェックに失敗しました", slog.String("error", err.Error()))
+		return err
+	}
+
 	return nil
 }
 
-func validateInitialData(ctx context.Context, clientConfig webapp.ClientConfig) error {
+func validateInitialData(ctx context.Context, clientConfig webapp.ClientConfig, features world.Features) error {
 	validationData := LoadData()
 
 	cmpOptions := []cmp.Option{
-		cmpopts.SortSlices(func(i, j api.OwnerGetChairsOKChairsItem) bool {
+		cmpopts.SortSlices(func(i, j webapp.OwnerGetChairsOKChairsItem) bool {
 			return i.ID < j.ID
 		}),
 		cmpopts.SortSlices(func(i, j api.OwnerGetSalesOKChairsItem) bool {
@@ -98,6 +105,10 @@ func validateInitialData(ctx context.Context, clientConfig webapp.ClientConfig)
 		}),
 		cmpopts.SortSlices(func(i, j api.AppGetRidesOKRidesItem) bool { return i.ID < j.ID }),
 	}
+	if !features.SurgePricing {
+		// サージ倍率に対応していない実装は倍率を返さない
+		cmpOptions = append(cmpOptions, cmpopts.IgnoreFields(webapp.AppPostRidesEstimatedFareOK{}, "SurgeRate"))
+	}
 
 	{
 		ownerClient, err := webapp.NewClient(clientConfig)
@@ -160,7 +171,7 @@ func validateInitialData(ctx context.Context, clientConfig webapp.ClientConfig)
 			return errors.New("GET /api/app/rides のレスポンスの内容が期待したものと一致しません")
 		}
 
-		estimated1, err := userClient.AppPostRidesEstimatedFare(ctx, &api.AppPostRidesEstimatedFareReq{
+		estimated1, err := userClient.AppPostRidesEstimatedFare(ctx, &webapp.AppPostRidesEstimatedFareReq{
 			PickupCoordinate:      api.Coordinate{Latitude: 0 + 10, Longitude: 0 + 10},
 			DestinationCoordinate: api.Coordinate{Latitude: 3 + 10, Longitude: 10 + 10},
 		})
@@ -171,7 +182,7 @@ func validateInitialData(ctx context.Context, clientConfig webapp.ClientConfig)
 			return errors.New("POST /api/app/rides/estimated-fare のレスポンスの内容が期待したものと一致しません")
 		}
 
-		estimated2, err := userClient.AppPostRidesEstimatedFare(ctx, &api.AppPostRidesEstimatedFareReq{
+		estimated2, err := userClient.AppPostRidesEstimatedFare(ctx, &webapp.AppPostRidesEstimatedFareReq{
 			PickupCoordinate:      api.Coordinate{Latitude: 0 - 10, Longitude: 0 - 10},
 			DestinationCoordinate: api.Coordinate{Latitude: -11 - 10, Longitude: 10 - 10},
 		})
@@ -197,7 +208,7 @@ func validateInitialData(ctx context.Context, clientConfig webapp.ClientConfig)
 			return errors.New("GET /api/app/rides のレスポンスの内容が期待したものと一致しません")
 		}
 
-		estimated1, err := userClient.AppPostRidesEstimatedFare(ctx, &api.AppPostRidesEstimatedFareReq{
+		estimated1, err := userClient.AppPostRidesEstimatedFare(ctx, &webapp.AppPostRidesEstimatedFareReq{
 			PickupCoordinate:      api.Coordinate{Latitude: 0 + 10, Longitude: 0 + 10},
 			DestinationCoordinate: api.Coordinate{Latitude: 3 + 10, Longitude: 10 + 10},
 		})
@@ -208,7 +219,7 @@ func validateInitialData(ctx context.Context, clientConfig webapp.ClientConfig)
 			return errors.New("POST /api/app/rides/estimated-fare のレスポンスの内容が期待したものと一致しません")
 		}
 
-		estimated2, err := userClient.AppPostRidesEstimatedFare(ctx, &api.AppPostRidesEstimatedFareReq{
+		estimated2, err := userClient.AppPostRidesEstimatedFare(ctx, &webapp.AppPostRidesEstimatedFareReq{
 			PickupCoordinate:      api.Coordinate{Latitude: 0 - 10, Longitude: 0 - 10},
 			DestinationCoordinate: api.Coordinate{Latitude: -11 - 10, Longitude: 10 - 10},
 		})
@@ -244,7 +255,7 @@ func validateSuccessFlow(ctx context.Context, clientConfig webapp.ClientConfig)
 			Username:    "hoge",
 			Firstname:   "hoge",
 			Lastname:    "hoge",
-			DateOfBirth: "2000-01-01",
+			DateOfBirth: time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC),
 		})
 		if err != nil {
 			return err
@@ -269,7 +280,7 @@ func validateSuccessFlow(ctx context.Context, clientConfig webapp.ClientConfig)
 	// POST /api/app/requests
 	requestID := ""
 	{
-		result, err := userClient.AppPostRequest(ctx, &api.AppPostRidesReq{
+		result, err := userClient.AppPostRequest(ctx, &webapp.AppPostRidesReq{
 			PickupCoordinate: api.Coordinate{
 				Latitude:  0,
 				Longitude: 0,
@@ -594,8 +605,8 @@ func validateSuccessFlow(ctx context.Context, clientConfig webapp.ClientConfig)
 			if err := validateAppNotification(result.Data.V, requestID, api.RideStatusCOMPLETED); err != nil {
 				return err
 			}
-			if result.Data.V.Chair.Value.Stats.TotalEvaluationAvg != 5 {
-				return fmt.Errorf("GET /api/app/nearby-chairs の返却するchairのstatsのtotal_evaluation_avgが異なります (expected:%f, actual:%f)", 5.0, result.Data.V.Chair.Value.Stats.TotalEvaluationAvg)
+			if result.Data.V.Chair.Value.Stats.TotalEvaluationAvg.Or(0) != 5 {
+				return fmt.Errorf("GET /api/app/nearby-chairs の返却するchairのstatsのtotal_evaluation_avgが異なります (expected:%f, actual:%f)", 5.0, result.Data.V.Chair.Value.Stats.TotalEvaluationAvg.Or(0))
 			}
 			if result.Data.V.Chair.Value.Stats.TotalRidesCount != 1 {
 				return fmt.Errorf("GET /api/app/nearby-chairs の返却するchairのstatsのtotal_rides_countが異なります (expected:%d, actual:%d)", 1, result.Data.V.Chair.Value.Stats.TotalRidesCount)
@@ -607,6 +618,190 @@ func validateSuccessFlow(ctx context.Context, clientConfig webapp.ClientConfig)
 	return nil
 }
 
+// 配車位置に向かっている椅子のライドをキャンセルして、キャンセル料が支払われることを検証する
+// 他の椅子が選ばれないように、椅子は負荷走行では使われない地域の配車位置に置く
+func (s *Scenario) validateCancelFlow(ctx context.Context, clientConfig webapp.ClientConfig) error {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	userClient, err := webapp.NewClient(clientConfig)
+	if err != nil {
+		return err
+	}
+	ownerClient, err := webapp.NewClient(clientConfig)
+	if err != nil {
+		return err
+	}
+	chairClient, err := webapp.NewClient(clientConfig)
+	if err != nil {
+		return err
+	}
+
+	pickup := api.Coordinate{Latitude: -500, Longitude: -500}
+	destination := api.Coordinate{Latitude: -490, Longitude: -490}
+
+	// POST /api/app/register
+	{
+		_, err := userClient.AppPostRegister(ctx, &api.AppPostUsersReq{
+			Username:    "prevalidation-cancel",
+			Firstname:   "cancel",
+			Lastname:    "prevalidation",
+			DateOfBirth: time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	paymentToken := "prevalidation-cancel-token"
+	payments := concurrent.NewSimpleSlice[int]()
+	s.world.PaymentDB.PrevalidationPayments.Set(paymentToken, payments)
+	// POST /api/app/payment-methods
+	{
+		_, err := userClient.AppPostPaymentMethods(ctx, &api.AppPostPaymentMethodsReq{
+			Token: paymentToken,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	chairRegisterToken := ""
+	// POST /api/owner/register
+	{
+		result, err := ownerClient.OwnerPostRegister(ctx, &api.OwnerPostOwnersReq{
+			Name: "prevalidation-cancel",
+		})
+		if err != nil {
+			return err
+		}
+		chairRegisterToken = result.ChairRegisterToken
+	}
+
+	// POST /api/chair/register
+	{
+		_, err := chairClient.ChairPostRegister(ctx, &api.ChairPostChairsReq{
+			Name:               "prevalidation-cancel",
+			Model:              "リラックスシート NEO",
+			ChairRegisterToken: chairRegisterToken,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	// POST /api/chair/activity
+	{
+		_, err := chairClient.ChairPostActivity(ctx, &api.ChairPostActivityReq{
+			IsActive: true,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	// 負荷走行のライドとマッチングしないように、検証が終わったら椅子を止める
+	defer chairClient.ChairPostActivity(context.WithoutCancel(ctx), &api.ChairPostActivityReq{
+		IsActive: false,
+	})
+
+	// POST /api/chair/coordinate
+	{
+		_, err := chairClient.ChairPostCoordinate(ctx, &pickup)
+		if err != nil {
+			return err
+		}
+	}
+
+	requestID := ""
+	// POST /api/app/rides
+	{
+		result, err := userClient.AppPostRequest(ctx, &webapp.AppPostRidesReq{
+			PickupCoordinate:      pickup,
+			DestinationCoordinate: destination,
+		})
+		if err != nil {
+			return err
+		}
+		requestID = result.RideID
+	}
+
+	if err := waitChairNotification(ctx, chairClient, requestID, api.RideStatusMATCHING); err != nil {
+		return err
+	}
+
+	// POST /api/chair/rides/:ride_id/status
+	{
+		_, err := chairClient.ChairPostRideStatus(ctx, requestID, &api.ChairPostRideStatusReq{
+			Status: api.ChairPostRideStatusReqStatusENROUTE,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := waitAppNotification(ctx, userClient, requestID, api.RideStatusENROUTE); err != nil {
+		return err
+	}
+
+	// POST /api/app/rides/:ride_id/cancel
+	{
+		result, err := userClient.AppPostRideCancel(ctx, requestID)
+		if err != nil {
+			return err
+		}
+		if result.CancellationFee != 500 {
+			return fmt.Errorf("POST /api/app/rides/:ride_id/cancel の返却するcancellation_feeが異なります (expected:%d, actual:%d)", 500, result.CancellationFee)
+		}
+	}
+
+	if err := waitAppNotification(ctx, userClient, requestID, api.RideStatusCANCELED); err != nil {
+		return err
+	}
+	if err := waitChairNotification(ctx, chairClient, requestID, api.RideStatusCANCELED); err != nil {
+		return err
+	}
+
+	// キャンセル料は非同期に支払われるので、支払いが届くまで待つ
+	for payments.Len() == 0 {
+		select {
+		case <-ctx.Done():
+			return errors.New("キャンセル料の支払いが行われませんでした")
+		case <-time.After(100 * time.Millisecond):
+		}
+	}
+	if amounts := payments.ToSlice(); len(amounts) != 1 || amounts[0] != 500 {
+		return fmt.Errorf("キャンセル料の支払額が異なります (expected:%v, actual:%v)", []int{500}, amounts)
+	}
+
+	return nil
+}
+
+// waitAppNotification ライドが指定した状態になったことが通知されるまで待つ
+func waitAppNotification(ctx context.Context, client *webapp.Client, requestID string, status api.RideStatus) error {
+	for result, err := range client.AppGetNotification(ctx) {
+		if err != nil {
+			return err
+		}
+		if result.Data.Valid && result.Data.V.RideID == requestID && result.Data.V.Status == status {
+			return nil
+		}
+	}
+	return fmt.Errorf("GET /api/app/notification で、ライドの状態が%sになったことが通知されませんでした", status)
+}
+
+// waitChairNotification ライドが指定した状態になったことが通知されるまで待つ
+func waitChairNotification(ctx context.Context, client *webapp.Client, requestID string, status api.RideStatus) error {
+	for result, err := range client.ChairGetNotification(ctx) {
+		if err != nil {
+			return err
+		}
+		if result.Data.Valid && result.Data.V.RideID == requestID && result.Data.V.Status == status {
+			return nil
+		}
+	}
+	return fmt.Errorf("GET /api/chair/notification で、ライドの状態が%sになったことが通知されませんでした", status)
+}
+
 func validateAppNotification(req webapp.UserNotificationData, requestID string, status api.RideStatus) error {
 	if req.RideID != requestID {
 		return fmt.Errorf("GET /api/app/notification の返却するIDが、リクエストIDと一致しません (expected:%s, actual:%s)", requestID, req.RideID)
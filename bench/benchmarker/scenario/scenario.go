@@ -61,14 +61,14 @@ type Scenario struct {
 	sendResultWait            sync.WaitGroup
 }
 
-func NewScenario(target, addr, paymentURL string, paymentBindPort int, logger *slog.Logger, reporter benchrun.Reporter, meter metric.Meter, prepareOnly bool, skipStaticFileSanityCheck bool) *Scenario {
+func NewScenario(target, addr, paymentURL string, paymentBindPort int, logger *slog.Logger, reporter benchrun.Reporter, meter metric.Meter, prepareOnly bool, skipStaticFileSanityCheck bool, features world.Features) *Scenario {
 	completedRequestChan := make(chan *world.Request, 1000)
 	worldClient := worldclient.NewWorldClient(context.Background(), webapp.ClientConfig{
 		TargetBaseURL:         target,
 		TargetAddr:            addr,
 		ClientIdleConnTimeout: 10 * time.Second,
 	}, skipStaticFileSanityCheck)
-	w := world.NewWorld(30*time.Millisecond, completedRequestChan, worldClient, logger)
+	w := world.NewWorld(30*time.Millisecond, completedRequestChan, worldClient, features, logger)
 
 	worldCtx := world.NewContext(w)
 
@@ -46,6 +46,8 @@ const (
 	ErrorCodeFailedToGetStaticFile
 	// ErrorCodeInvalidContent 静的ファイルの内容が一致しないエラー
 	ErrorCodeInvalidContent
+	// ErrorCodeFailedToPostRiderEvaluate ユーザーの評価の送信に失敗したエラー
+	ErrorCodeFailedToPostRiderEvaluate
 )
 
 type codeError struct {
@@ -63,11 +63,15 @@ func (c *WorldClient) RegisterUser(ctx *world.Context, data *world.RegisterUserR
 		return nil, err
 	}
 
+	dateOfBirth, err := time.Parse(time.DateOnly, data.DateOfBirth)
+	if err != nil {
+		return nil, err
+	}
 	response, err := client.AppPostRegister(c.ctx, &api.AppPostUsersReq{
 		Username:       data.UserName,
 		Firstname:      data.FirstName,
 		Lastname:       data.LastName,
-		DateOfBirth:    data.DateOfBirth,
+		DateOfBirth:    dateOfBirth,
 		InvitationCode: api.OptString{Set: len(data.InvitationCode) > 0, Value: data.InvitationCode},
 	})
 	if err != nil {
@@ -175,7 +179,7 @@ func (c *ownerClient) GetOwnerChairs(ctx *world.Context, args *world.GetOwnerCha
 		return nil, err
 	}
 
-	return &world.GetOwnerChairsResponse{Chairs: lo.Map(response.Chairs, func(v api.OwnerGetChairsOKChairsItem, _ int) *world.OwnerChair {
+	return &world.GetOwnerChairsResponse{Chairs: lo.Map(response.Chairs, func(v webapp.OwnerGetChairsOKChairsItem, _ int) *world.OwnerChair {
 		return &world.OwnerChair{
 			ID:                     v.ID,
 			Name:                   v.Name,
@@ -183,7 +187,8 @@ func (c *ownerClient) GetOwnerChairs(ctx *world.Context, args *world.GetOwnerCha
 			Active:                 v.Active,
 			RegisteredAt:           time.UnixMilli(v.RegisteredAt),
 			TotalDistance:          v.TotalDistance,
-			TotalDistanceUpdatedAt: null.NewTime(time.UnixMilli(v.TotalDistanceUpdatedAt.Value), v.TotalDistanceUpdatedAt.Set),
+			TotalDistanceUpdatedAt: null.NewTime(time.UnixMilli(v.TotalDistanceUpdatedAt.Int64), v.TotalDistanceUpdatedAt.Valid),
+			RetiredAt:              null.NewTime(time.UnixMilli(v.RetiredAt.Int64), v.RetiredAt.Valid),
 		}
 	})}, nil
 }
@@ -218,6 +223,17 @@ func (c *chairClient) SendAcceptRequest(ctx *world.Context, chair *world.Chair,
 	return nil
 }
 
+func (c *chairClient) SendDenyRequest(ctx *world.Context, chair *world.Chair, req *world.Request) error {
+	_, err := c.client.ChairPostRideStatus(c.ctx, req.ServerID, &api.ChairPostRideStatusReq{
+		Status: api.ChairPostRideStatusReqStatusREJECT,
+	})
+	if err != nil {
+		return WrapCodeError(ErrorCodeFailedToPostDeny, err)
+	}
+
+	return nil
+}
+
 func (c *chairClient) SendDepart(ctx *world.Context, req *world.Request) error {
 	_, err := c.client.ChairPostRideStatus(c.ctx, req.ServerID, &api.ChairPostRideStatusReq{
 		Status: api.ChairPostRideStatusReqStatusCARRYING,
@@ -229,6 +245,17 @@ func (c *chairClient) SendDepart(ctx *world.Context, req *world.Request) error {
 	return nil
 }
 
+func (c *chairClient) SendRiderEvaluation(ctx *world.Context, req *world.Request, score int) error {
+	_, err := c.client.ChairPostRideEvaluation(c.ctx, req.ServerID, &api.ChairPostRideEvaluationReq{
+		Evaluation: score,
+	})
+	if err != nil {
+		return WrapCodeError(ErrorCodeFailedToPostRiderEvaluate, err)
+	}
+
+	return nil
+}
+
 func (c *chairClient) SendActivate(ctx *world.Context, chair *world.Chair) error {
 	_, err := c.client.ChairPostActivity(c.ctx, &api.ChairPostActivityReq{
 		IsActive: true,
@@ -268,6 +295,11 @@ func (c *chairClient) ConnectChairNotificationStream(ctx *world.Context, chair *
 							Destination: world.C(data.DestinationCoordinate.Latitude, data.DestinationCoordinate.Longitude),
 						}
 						switch data.Status {
+						case api.RideStatusSCHEDULED:
+							event = &world.ChairNotificationEventScheduled{
+								ServerRequestID:        data.RideID,
+								ChairNotificationEvent: notificationEvent,
+							}
 						case api.RideStatusMATCHING:
 							event = &world.ChairNotificationEventMatched{
 								ServerRequestID:        data.RideID,
@@ -288,6 +320,14 @@ func (c *chairClient) ConnectChairNotificationStream(ctx *world.Context, chair *
 								ServerRequestID:        data.RideID,
 								ChairNotificationEvent: notificationEvent,
 							}
+						case api.RideStatusWAYPOINT:
+							stops, arrivedStops := fromAPIRideStops(data.Stops)
+							event = &world.ChairNotificationEventWaypoint{
+								ServerRequestID:        data.RideID,
+								ChairNotificationEvent: notificationEvent,
+								Stops:                  stops,
+								ArrivedStops:           arrivedStops,
+							}
 						case api.RideStatusARRIVED:
 							event = &world.ChairNotificationEventArrived{
 								ServerRequestID:        data.RideID,
@@ -336,7 +376,7 @@ func (c *userClient) SendEvaluation(ctx *world.Context, req *world.Request, scor
 func (c *userClient) SendCreateRequest(ctx *world.Context, req *world.Request) (*world.SendCreateRequestResponse, error) {
 	pickup := req.PickupPoint
 	destination := req.DestinationPoint
-	response, err := c.client.AppPostRequest(c.ctx, &api.AppPostRidesReq{
+	reqBody := &webapp.AppPostRidesReq{
 		PickupCoordinate: api.Coordinate{
 			Latitude:  pickup.X,
 			Longitude: pickup.Y,
@@ -345,12 +385,21 @@ func (c *userClient) SendCreateRequest(ctx *world.Context, req *world.Request) (
 			Latitude:  destination.X,
 			Longitude: destination.Y,
 		},
-	})
+		Stops: toAPICoordinates(req.Stops),
+	}
+	if !req.ScheduledAt.IsZero() {
+		reqBody.ScheduledAt = lo.ToPtr(req.ScheduledAt.UnixMilli())
+	}
+	response, err := c.client.AppPostRequest(c.ctx, reqBody)
 	if err != nil {
 		return nil, WrapCodeError(ErrorCodeFailedToPostRequest, err)
 	}
 
-	return &world.SendCreateRequestResponse{ServerRequestID: response.RideID}, nil
+	return &world.SendCreateRequestResponse{
+		ServerRequestID: response.RideID,
+		Fare:            response.Fare,
+		SurgeRate:       response.SurgeRate,
+	}, nil
 }
 
 func (c *userClient) RegisterPaymentMethods(ctx *world.Context, user *world.User) error {
@@ -392,13 +441,19 @@ func (c *userClient) GetRequests(ctx *world.Context) (*world.GetRequestsResponse
 		}
 	}
 
+	scheduledRequestIDs := make([]string, len(res.ScheduledRides))
+	for i, r := range res.ScheduledRides {
+		scheduledRequestIDs[i] = r.ID
+	}
+
 	return &world.GetRequestsResponse{
-		Requests: requests,
+		Requests:            requests,
+		ScheduledRequestIDs: scheduledRequestIDs,
 	}, nil
 }
 
-func (c *userClient) GetEstimatedFare(ctx *world.Context, pickup world.Coordinate, dest world.Coordinate) (*world.GetEstimatedFareResponse, error) {
-	res, err := c.client.AppPostRidesEstimatedFare(c.ctx, &api.AppPostRidesEstimatedFareReq{
+func (c *userClient) GetEstimatedFare(ctx *world.Context, pickup world.Coordinate, stops []world.Coordinate, dest world.Coordinate) (*world.GetEstimatedFareResponse, error) {
+	res, err := c.client.AppPostRidesEstimatedFare(c.ctx, &webapp.AppPostRidesEstimatedFareReq{
 		PickupCoordinate: api.Coordinate{
 			Latitude:  pickup.X,
 			Longitude: pickup.Y,
@@ -407,13 +462,15 @@ func (c *userClient) GetEstimatedFare(ctx *world.Context, pickup world.Coordinat
 			Latitude:  dest.X,
 			Longitude: dest.Y,
 		},
+		Stops: toAPICoordinates(stops),
 	})
 	if err != nil {
 		return nil, WrapCodeError(ErrorCodeFailedToPostRidesEstimatedFare, err)
 	}
 	return &world.GetEstimatedFareResponse{
-		Fare:     res.Fare,
-		Discount: res.Discount,
+		Fare:      res.Fare,
+		Discount:  res.Discount,
+		SurgeRate: res.SurgeRate,
 	}, nil
 }
 
@@ -471,11 +528,16 @@ func (c *userClient) ConnectUserNotificationStream(ctx *world.Context, user *wor
 								Model: data.Chair.Value.Model,
 								Stats: world.UserNotificationEventChairStatsPayload{
 									TotalRidesCount:    data.Chair.Value.Stats.TotalRidesCount,
-									TotalEvaluationAvg: data.Chair.Value.Stats.TotalEvaluationAvg,
+									TotalEvaluationAvg: data.Chair.Value.Stats.TotalEvaluationAvg.Or(0),
 								},
 							}
 						}
 						switch data.Status {
+						case api.RideStatusSCHEDULED:
+							event = &world.UserNotificationEventScheduled{
+								ServerRequestID:       data.RideID,
+								UserNotificationEvent: userNotificationEvent,
+							}
 						case api.RideStatusMATCHING:
 							event = &world.UserNotificationEventMatching{
 								ServerRequestID:       data.RideID,
@@ -496,6 +558,14 @@ func (c *userClient) ConnectUserNotificationStream(ctx *world.Context, user *wor
 								ServerRequestID:       data.RideID,
 								UserNotificationEvent: userNotificationEvent,
 							}
+						case api.RideStatusWAYPOINT:
+							stops, arrivedStops := fromAPIRideStops(data.Stops)
+							event = &world.UserNotificationEventWaypoint{
+								ServerRequestID:       data.RideID,
+								UserNotificationEvent: userNotificationEvent,
+								Stops:                 stops,
+								ArrivedStops:          arrivedStops,
+							}
 						case api.RideStatusARRIVED:
 							event = &world.UserNotificationEventArrived{
 								ServerRequestID:       data.RideID,
@@ -567,3 +637,26 @@ func browserAccess(ctx context.Context, client *webapp.Client, scenario benchrun
 
 	return nil
 }
+
+// toAPICoordinates 経由地をリクエストの形式にする。経由地が無ければnilを返す
+func toAPICoordinates(coordinates []world.Coordinate) []api.Coordinate {
+	if len(coordinates) == 0 {
+		return nil
+	}
+	return lo.Map(coordinates, func(c world.Coordinate, _ int) api.Coordinate {
+		return api.Coordinate{Latitude: c.X, Longitude: c.Y}
+	})
+}
+
+// fromAPIRideStops 通知された経由地の位置と、そのうち到着済みの数を返す
+func fromAPIRideStops(stops []api.RideStop) ([]world.Coordinate, int) {
+	coordinates := make([]world.Coordinate, 0, len(stops))
+	arrivedStops := 0
+	for _, stop := range stops {
+		coordinates = append(coordinates, world.C(stop.Coordinate.Latitude, stop.Coordinate.Longitude))
+		if stop.ArrivedAt.Set {
+			arrivedStops++
+		}
+	}
+	return coordinates, arrivedStops
+}
@@ -16,6 +16,13 @@ import (
 
 // Invoker invokes operations described by OpenAPI v3 specification.
 type Invoker interface {
+	// AppDeletePaymentMethod invokes app-delete-payment-method operation.
+	//
+	// 終わっていないライドや、まだ決済が済んでいない支払いに使う決済トークンは削除できない
+	// デフォルトの決済トークンを削除した場合は、残っているうち最後に登録したものがデフォルトになる.
+	//
+	// DELETE /app/payment-methods/{payment_method_id}
+	AppDeletePaymentMethod(ctx context.Context, params AppDeletePaymentMethodParams) (AppDeletePaymentMethodRes, error)
 	// AppGetNearbyChairs invokes app-get-nearby-chairs operation.
 	//
 	// 椅子からサーバーに記録された座標情報は3秒以内に反映されている必要があります。.
@@ -24,58 +31,124 @@ type Invoker interface {
 	AppGetNearbyChairs(ctx context.Context, params AppGetNearbyChairsParams) (*AppGetNearbyChairsOK, error)
 	// AppGetNotification invokes app-get-notification operation.
 	//
-	// 最新の自分のライドの状態を取得・通知する.
+	// 最新の自分のライドの状態を取得・通知する
+	// `Accept: text/event-stream` を指定した場合はServer-Sent
+	// Eventsで、接続時点の状態と以降の状態の変化を1つずつ通知する.
 	//
 	// GET /app/notification
-	AppGetNotification(ctx context.Context) (*AppGetNotificationOK, error)
+	AppGetNotification(ctx context.Context) (*AppGetNotificationOKApplicationJSON, error)
+	// AppGetPaymentMethods invokes app-get-payment-methods operation.
+	//
+	// 登録した順に返す。決済トークンそのものは返さない.
+	//
+	// GET /app/payment-methods
+	AppGetPaymentMethods(ctx context.Context) (*AppGetPaymentMethodsOK, error)
+	// AppGetRidePayment invokes app-get-ride-payment operation.
+	//
+	// Paymentは運賃もしくはキャンセル料の決済で、評価前のライドでは`null`になる
+	// tipはチップの決済で、チップを払っていなければ含まれない.
+	//
+	// GET /app/rides/{ride_id}/payment
+	AppGetRidePayment(ctx context.Context, params AppGetRidePaymentParams) (AppGetRidePaymentRes, error)
 	// AppGetRides invokes app-get-rides operation.
 	//
-	// ユーザーが完了済みのライド一覧を取得する.
+	// ユーザーが完了済みのライド一覧と、マッチングを待っている予約済みのライド一覧を取得する.
 	//
 	// GET /app/rides
 	AppGetRides(ctx context.Context) (*AppGetRidesOK, error)
+	// AppPatchRide invokes app-patch-ride operation.
+	//
+	// MATCHINGになる前(SCHEDULED)のライドの配車日時・配車位置・目的地を変更できる。指定しなかった項目は変更しない
+	// 運賃は作成時のサージ倍率とクーポンを使って求め直す
+	// 配車日時をマッチングを始める時間まで早めた場合は、すぐにMATCHINGになる.
+	//
+	// PATCH /app/rides/{ride_id}
+	AppPatchRide(ctx context.Context, request OptAppPatchRideReq, params AppPatchRideParams) (AppPatchRideRes, error)
+	// AppPostCoupons invokes app-post-coupons operation.
+	//
+	// 期間中のコード入力用(CODE)キャンペーンのうち、code_prefixが入力したコードと一致するもののクーポンを受け取る
+	// 受け取ったクーポンは、キャンペーンの優先度が高いものから、同じ優先度なら受け取った順にライドに使われる.
+	//
+	// POST /app/coupons
+	AppPostCoupons(ctx context.Context, request OptAppPostCouponsReq) (AppPostCouponsRes, error)
+	// AppPostPaymentMethodDefault invokes app-post-payment-method-default operation.
+	//
+	// 以降に作成するライドで使われる。作成済みのライドの決済トークンは変わらない.
+	//
+	// POST /app/payment-methods/{payment_method_id}/default
+	AppPostPaymentMethodDefault(ctx context.Context, params AppPostPaymentMethodDefaultParams) (AppPostPaymentMethodDefaultRes, error)
 	// AppPostPaymentMethods invokes app-post-payment-methods operation.
 	//
 	// 決済トークンの登録.
 	//
 	// POST /app/payment-methods
 	AppPostPaymentMethods(ctx context.Context, request OptAppPostPaymentMethodsReq) (AppPostPaymentMethodsRes, error)
+	// AppPostRideCancel invokes app-post-ride-cancel operation.
+	//
+	// 乗車する前(SCHEDULED, MATCHING, ENROUTE, PICKUP)のライドをキャンセルできる
+	// 椅子が乗車位置に向かい始めた後(ENROUTE,
+	// PICKUP)のキャンセルにはキャンセル料がかかり、向かっていた椅子の売上になる
+	// ライドに使っていたクーポンは次のライドで使えるようになる.
+	//
+	// POST /app/rides/{ride_id}/cancel
+	AppPostRideCancel(ctx context.Context, params AppPostRideCancelParams) (AppPostRideCancelRes, error)
 	// AppPostRideEvaluation invokes app-post-ride-evaluation operation.
 	//
-	// ユーザーがライドを評価する.
+	// 決済はレスポンスを返すまでに社内の決済マイクロサービスへ送られる。ISUCON_ASYNC_PAYMENTを指定した実装では、評価の完了後にバックグラウンドで送られる。決済の状況は`GET /app/rides/{ride_id}/payment`で確認できる
+	// tipを指定すると、運賃とは別の決済として椅子にチップを払える.
 	//
 	// POST /app/rides/{ride_id}/evaluation
 	AppPostRideEvaluation(ctx context.Context, request OptAppPostRideEvaluationReq, params AppPostRideEvaluationParams) (AppPostRideEvaluationRes, error)
 	// AppPostRides invokes app-post-rides operation.
 	//
-	// ユーザーが配車を要求する.
+	// ユーザーがクーポンを所有している場合、自動で利用する
+	// サージ倍率は配車要求を受け付けた時点で確定し、以降の運賃・売上・決済額の計算にはこの倍率を使う
+	// pooledを指定すると相乗りを希望できる。同じ方向に向かう相乗りのライドを運んでいる椅子が割り当てられることがある
+	// 相乗りの割引は実際に相乗りになった時点で運賃に反映されるので、レスポンスの運賃は割引前のもの
+	// stopsを指定すると経由地を順に回ってから目的地に向かう。運賃は配車位置から経由地を順に回って目的地に着くまでの距離で求める
+	// scheduled_atを指定すると配車日時を予約できる。予約したライドはSCHEDULEDで作成され、配車日時の一定時間前(ISUCON_SCHEDULED_RIDE_LEAD_TIME)になるとMATCHINGになる
+	// payment_method_idを指定すると、運賃やキャンセル料をその決済トークンで支払う.
 	//
 	// POST /app/rides
 	AppPostRides(ctx context.Context, request OptAppPostRidesReq) (AppPostRidesRes, error)
 	// AppPostRidesEstimatedFare invokes app-post-rides-estimated-fare operation.
 	//
-	// ライドの運賃を見積もる.
+	// 配車位置の周辺で椅子を待っているライドが空いている椅子より多い場合、サージ倍率によって距離に応じた運賃が割り増しされる.
 	//
 	// POST /app/rides/estimated-fare
 	AppPostRidesEstimatedFare(ctx context.Context, request OptAppPostRidesEstimatedFareReq) (AppPostRidesEstimatedFareRes, error)
 	// AppPostUsers invokes app-post-users operation.
 	//
-	// ユーザーが会員登録を行う.
+	// 招待コードを用いて登録した場合は、招待クーポンを付与する.
 	//
 	// POST /app/users
 	AppPostUsers(ctx context.Context, request OptAppPostUsersReq) (AppPostUsersRes, error)
+	// ChairGetEarnings invokes chair-get-earnings operation.
+	//
+	// オーナーの売上情報と同じく、完了したライドの運賃と椅子が乗車位置に向かっていたライドのキャンセル料を売上に含める.
+	//
+	// GET /chair/earnings
+	ChairGetEarnings(ctx context.Context, params ChairGetEarningsParams) (ChairGetEarningsRes, error)
 	// ChairGetNotification invokes chair-get-notification operation.
 	//
-	// 自分に割り当てられた最新のライドの状態を取得・通知する.
+	// 自分に割り当てられた最新のライドの状態を取得・通知する
+	// `Accept: text/event-stream` を指定した場合はServer-Sent
+	// Eventsで、接続時点の状態と以降の状態の変化を1つずつ通知する.
 	//
 	// GET /chair/notification
-	ChairGetNotification(ctx context.Context) (*ChairGetNotificationOK, error)
+	ChairGetNotification(ctx context.Context) (*ChairGetNotificationOKApplicationJSON, error)
+	// ChairGetRides invokes chair-get-rides operation.
+	//
+	// 椅子が自身に割り当てられたライドの履歴を新しい順に取得する.
+	//
+	// GET /chair/rides
+	ChairGetRides(ctx context.Context, params ChairGetRidesParams) (ChairGetRidesRes, error)
 	// ChairPostActivity invokes chair-post-activity operation.
 	//
 	// 椅子が配車受付を開始・停止する.
 	//
 	// POST /chair/activity
-	ChairPostActivity(ctx context.Context, request OptChairPostActivityReq) error
+	ChairPostActivity(ctx context.Context, request OptChairPostActivityReq) (ChairPostActivityRes, error)
 	// ChairPostChairs invokes chair-post-chairs operation.
 	//
 	// オーナーが椅子の登録を行う.
@@ -88,36 +161,140 @@ type Invoker interface {
 	//
 	// POST /chair/coordinate
 	ChairPostCoordinate(ctx context.Context, request OptCoordinate) (*ChairPostCoordinateOK, error)
+	// ChairPostRideEvaluation invokes chair-post-ride-evaluation operation.
+	//
+	// 椅子が完了したライドのユーザーを評価する.
+	//
+	// POST /chair/rides/{ride_id}/evaluation
+	ChairPostRideEvaluation(ctx context.Context, request OptChairPostRideEvaluationReq, params ChairPostRideEvaluationParams) (ChairPostRideEvaluationRes, error)
 	// ChairPostRideStatus invokes chair-post-ride-status operation.
 	//
 	// 椅子がライドのステータスを更新する.
 	//
 	// POST /chair/rides/{ride_id}/status
 	ChairPostRideStatus(ctx context.Context, request OptChairPostRideStatusReq, params ChairPostRideStatusParams) (ChairPostRideStatusRes, error)
+	// InternalGetCouponCampaigns invokes internal-get-coupon-campaigns operation.
+	//
+	// *内部からのみアクセス可能としている*
+	// ライドに適用する優先度の高い順に返す.
+	//
+	// GET /internal/coupon-campaigns
+	InternalGetCouponCampaigns(ctx context.Context) (*InternalGetCouponCampaignsOK, error)
 	// InternalGetMatching invokes internal-get-matching operation.
 	//
-	// ライドのマッチングを行う.
+	// *内部からのみアクセス可能としている*.
 	//
 	// GET /internal/matching
 	InternalGetMatching(ctx context.Context) error
+	// InternalPostCouponCampaigns invokes internal-post-coupon-campaigns operation.
+	//
+	// *内部からのみアクセス可能としている*。キャンペーンは全てのユーザーに影響するので、オーナーからは作成できない
+	// SIGNUP・INVITEE・INVITERのキャンペーンは、それぞれの種類で期間中の最も優先度の高いものが登録時・招待時に使われる.
+	//
+	// POST /internal/coupon-campaigns
+	InternalPostCouponCampaigns(ctx context.Context, request OptInternalPostCouponCampaignsReq) (InternalPostCouponCampaignsRes, error)
+	// OwnerGetChairRides invokes owner-get-chair-rides operation.
+	//
+	// 椅子のオーナーが管理している椅子のライドの履歴を取得する.
+	//
+	// GET /owner/chairs/{chair_id}/rides
+	OwnerGetChairRides(ctx context.Context, params OwnerGetChairRidesParams) (OwnerGetChairRidesRes, error)
+	// OwnerGetChairTrack invokes owner-get-chair-track operation.
+	//
+	// 椅子のオーナーが管理している椅子の、指定期間の移動の軌跡を取得する.
+	//
+	// GET /owner/chairs/{chair_id}/track
+	OwnerGetChairTrack(ctx context.Context, params OwnerGetChairTrackParams) (OwnerGetChairTrackRes, error)
 	// OwnerGetChairs invokes owner-get-chairs operation.
 	//
 	// 椅子のオーナーが管理している椅子の一覧を取得する.
 	//
 	// GET /owner/chairs
 	OwnerGetChairs(ctx context.Context) (*OwnerGetChairsOK, error)
+	// OwnerGetFailedPayments invokes owner-get-failed-payments operation.
+	//
+	// リトライし尽くした(FAILED)、もしくは決済マイクロサービスに拒否された(REJECTED)決済を更新日時の降順で返す.
+	//
+	// GET /owner/failed-payments
+	OwnerGetFailedPayments(ctx context.Context) (*OwnerGetFailedPaymentsOK, error)
+	// OwnerGetRideRefunds invokes owner-get-ride-refunds operation.
+	//
+	// 椅子のオーナーが管理している椅子のライドの返金を登録順に取得する.
+	//
+	// GET /owner/rides/{ride_id}/refunds
+	OwnerGetRideRefunds(ctx context.Context, params OwnerGetRideRefundsParams) (OwnerGetRideRefundsRes, error)
+	// OwnerGetRideRoute invokes owner-get-ride-route operation.
+	//
+	// 到着する前にキャンセルされたライドはキャンセルまで、進行中のライドは現在までの軌跡を返す
+	// 配車位置へ向かい始めていないライドでは軌跡は空になる.
+	//
+	// GET /owner/chairs/{chair_id}/rides/{ride_id}/route
+	OwnerGetRideRoute(ctx context.Context, params OwnerGetRideRouteParams) (OwnerGetRideRouteRes, error)
 	// OwnerGetSales invokes owner-get-sales operation.
 	//
-	// 椅子のオーナーが指定期間の全体・椅子ごと・モデルごとの売上情報を取得する.
+	// 完了したライドの運賃に加えて、椅子が乗車位置に向かっていたライドのキャンセル料も売上に含める
+	// 相乗りしたライドは、それぞれのライドの相乗りの割引後の運賃が運んだ椅子の売上になる
+	// 返金は、決済マイクロサービスで返金が済んだものだけを返金を登録した日時の売上から差し引く。そのため期間によっては売上が負の値になる
+	// チップは売上に含めず、期間中に完了したライドのチップを別に集計する.
 	//
 	// GET /owner/sales
 	OwnerGetSales(ctx context.Context, params OwnerGetSalesParams) (*OwnerGetSalesOK, error)
+	// OwnerGetSalesExport invokes owner-get-sales-export operation.
+	//
+	// 完了したライドの運賃・キャンセル料・返金をそれぞれ1行にし、発生日時の順に書き出す。返金は済んだものだけを書き出し、返金の行のsalesは負の値になる
+	// 各行の項目はkind (RIDE, CANCELLATION もしくは REFUND), ride_id, chair_id, chair_name,
+	// model, occurred_at (UNIXミリ秒), sales, evaluation
+	// CSVでは1行目に項目名を書き出し、評価が無い行のevaluationは空になる.
+	//
+	// GET /owner/sales/export
+	OwnerGetSalesExport(ctx context.Context, params OwnerGetSalesExportParams) (OwnerGetSalesExportRes, error)
+	// OwnerGetSalesTimeseries invokes owner-get-sales-timeseries operation.
+	//
+	// 売上の考え方は`GET
+	// /owner/sales`と同じ。キャンセル料や返金は売上に含めるが、ライドの数や評価の平均には含めない
+	// 売上もライドも無い集計単位は含まれない.
+	//
+	// GET /owner/sales/timeseries
+	OwnerGetSalesTimeseries(ctx context.Context, params OwnerGetSalesTimeseriesParams) (OwnerGetSalesTimeseriesRes, error)
+	// OwnerPatchChair invokes owner-patch-chair operation.
+	//
+	// 指定しなかった項目は変更しない。モデルは登録されているモデルにしか変更できない.
+	//
+	// PATCH /owner/chairs/{chair_id}
+	OwnerPatchChair(ctx context.Context, request OptOwnerPatchChairReq, params OwnerPatchChairParams) (OwnerPatchChairRes, error)
+	// OwnerPostChairAccessToken invokes owner-post-chair-access-token operation.
+	//
+	// 以前のアクセストークンは使えなくなる.
+	//
+	// POST /owner/chairs/{chair_id}/access-token
+	OwnerPostChairAccessToken(ctx context.Context, params OwnerPostChairAccessTokenParams) (OwnerPostChairAccessTokenRes, error)
+	// OwnerPostChairDeactivate invokes owner-post-chair-deactivate operation.
+	//
+	// 進行中のライドはそのまま続けられる。椅子は自身で再び稼働できる.
+	//
+	// POST /owner/chairs/{chair_id}/deactivate
+	OwnerPostChairDeactivate(ctx context.Context, params OwnerPostChairDeactivateParams) (OwnerPostChairDeactivateRes, error)
+	// OwnerPostChairRetire invokes owner-post-chair-retire operation.
+	//
+	// 引退した椅子はマッチングや周辺の椅子の検索の対象にならず、再び稼働させることもできない
+	// 売上やライドの履歴は引退後も残る.
+	//
+	// POST /owner/chairs/{chair_id}/retire
+	OwnerPostChairRetire(ctx context.Context, params OwnerPostChairRetireParams) (OwnerPostChairRetireRes, error)
 	// OwnerPostOwners invokes owner-post-owners operation.
 	//
 	// 椅子のオーナーが会員登録を行う.
 	//
 	// POST /owner/owners
 	OwnerPostOwners(ctx context.Context, request OptOwnerPostOwnersReq) (OwnerPostOwnersRes, error)
+	// OwnerPostRideRefund invokes owner-post-ride-refund operation.
+	//
+	// 決済が成功したライドの決済額の全額もしくは一部を返金する。返金額の合計は決済額を超えられない
+	// 返金はバックグラウンドで決済マイクロサービスに送り、失敗した場合は決済と同じようにリトライする
+	// 返金が済んだら、その額を返金を登録した日時の椅子の売上から差し引く.
+	//
+	// POST /owner/rides/{ride_id}/refunds
+	OwnerPostRideRefund(ctx context.Context, request OptOwnerPostRideRefundReq, params OwnerPostRideRefundParams) (OwnerPostRideRefundRes, error)
 	// PostInitialize invokes post-initialize operation.
 	//
 	// サービスを初期化する.
@@ -170,6 +347,61 @@ func (c *Client) requestURL(ctx context.Context) *url.URL {
 	return u
 }
 
+// AppDeletePaymentMethod invokes app-delete-payment-method operation.
+//
+// 終わっていないライドや、まだ決済が済んでいない支払いに使う決済トークンは削除できない
+// デフォルトの決済トークンを削除した場合は、残っているうち最後に登録したものがデフォルトになる.
+//
+// DELETE /app/payment-methods/{payment_method_id}
+func (c *Client) AppDeletePaymentMethod(ctx context.Context, params AppDeletePaymentMethodParams) (AppDeletePaymentMethodRes, error) {
+	res, err := c.sendAppDeletePaymentMethod(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendAppDeletePaymentMethod(ctx context.Context, params AppDeletePaymentMethodParams) (res AppDeletePaymentMethodRes, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/app/payment-methods/"
+	{
+		// Encode "payment_method_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "payment_method_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.PaymentMethodID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "DELETE", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeAppDeletePaymentMethodResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
 // AppGetNearbyChairs invokes app-get-nearby-chairs operation.
 //
 // 椅子からサーバーに記録された座標情報は3秒以内に反映されている必要があります。.
@@ -256,15 +488,17 @@ func (c *Client) sendAppGetNearbyChairs(ctx context.Context, params AppGetNearby
 
 // AppGetNotification invokes app-get-notification operation.
 //
-// 最新の自分のライドの状態を取得・通知する.
+// 最新の自分のライドの状態を取得・通知する
+// `Accept: text/event-stream` を指定した場合はServer-Sent
+// Eventsで、接続時点の状態と以降の状態の変化を1つずつ通知する.
 //
 // GET /app/notification
-func (c *Client) AppGetNotification(ctx context.Context) (*AppGetNotificationOK, error) {
+func (c *Client) AppGetNotification(ctx context.Context) (*AppGetNotificationOKApplicationJSON, error) {
 	res, err := c.sendAppGetNotification(ctx)
 	return res, err
 }
 
-func (c *Client) sendAppGetNotification(ctx context.Context) (res *AppGetNotificationOK, err error) {
+func (c *Client) sendAppGetNotification(ctx context.Context) (res *AppGetNotificationOKApplicationJSON, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
@@ -290,66 +524,27 @@ func (c *Client) sendAppGetNotification(ctx context.Context) (res *AppGetNotific
 	return result, nil
 }
 
-// AppGetRides invokes app-get-rides operation.
-//
-// ユーザーが完了済みのライド一覧を取得する.
-//
-// GET /app/rides
-func (c *Client) AppGetRides(ctx context.Context) (*AppGetRidesOK, error) {
-	res, err := c.sendAppGetRides(ctx)
-	return res, err
-}
-
-func (c *Client) sendAppGetRides(ctx context.Context) (res *AppGetRidesOK, err error) {
-
-	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/app/rides"
-	uri.AddPathParts(u, pathParts[:]...)
-
-	r, err := ht.NewRequest(ctx, "GET", u)
-	if err != nil {
-		return res, errors.Wrap(err, "create request")
-	}
-
-	resp, err := c.cfg.Client.Do(r)
-	if err != nil {
-		return res, errors.Wrap(err, "do request")
-	}
-	defer resp.Body.Close()
-
-	result, err := decodeAppGetRidesResponse(resp)
-	if err != nil {
-		return res, errors.Wrap(err, "decode response")
-	}
-
-	return result, nil
-}
-
-// AppPostPaymentMethods invokes app-post-payment-methods operation.
+// AppGetPaymentMethods invokes app-get-payment-methods operation.
 //
-// 決済トークンの登録.
+// 登録した順に返す。決済トークンそのものは返さない.
 //
-// POST /app/payment-methods
-func (c *Client) AppPostPaymentMethods(ctx context.Context, request OptAppPostPaymentMethodsReq) (AppPostPaymentMethodsRes, error) {
-	res, err := c.sendAppPostPaymentMethods(ctx, request)
+// GET /app/payment-methods
+func (c *Client) AppGetPaymentMethods(ctx context.Context) (*AppGetPaymentMethodsOK, error) {
+	res, err := c.sendAppGetPaymentMethods(ctx)
 	return res, err
 }
 
-func (c *Client) sendAppPostPaymentMethods(ctx context.Context, request OptAppPostPaymentMethodsReq) (res AppPostPaymentMethodsRes, err error) {
+func (c *Client) sendAppGetPaymentMethods(ctx context.Context) (res *AppGetPaymentMethodsOK, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
 	pathParts[0] = "/app/payment-methods"
 	uri.AddPathParts(u, pathParts[:]...)
 
-	r, err := ht.NewRequest(ctx, "POST", u)
+	r, err := ht.NewRequest(ctx, "GET", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeAppPostPaymentMethodsRequest(request, r); err != nil {
-		return res, errors.Wrap(err, "encode request")
-	}
 
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
@@ -357,7 +552,7 @@ func (c *Client) sendAppPostPaymentMethods(ctx context.Context, request OptAppPo
 	}
 	defer resp.Body.Close()
 
-	result, err := decodeAppPostPaymentMethodsResponse(resp)
+	result, err := decodeAppGetPaymentMethodsResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -365,17 +560,18 @@ func (c *Client) sendAppPostPaymentMethods(ctx context.Context, request OptAppPo
 	return result, nil
 }
 
-// AppPostRideEvaluation invokes app-post-ride-evaluation operation.
+// AppGetRidePayment invokes app-get-ride-payment operation.
 //
-// ユーザーがライドを評価する.
+// Paymentは運賃もしくはキャンセル料の決済で、評価前のライドでは`null`になる
+// tipはチップの決済で、チップを払っていなければ含まれない.
 //
-// POST /app/rides/{ride_id}/evaluation
-func (c *Client) AppPostRideEvaluation(ctx context.Context, request OptAppPostRideEvaluationReq, params AppPostRideEvaluationParams) (AppPostRideEvaluationRes, error) {
-	res, err := c.sendAppPostRideEvaluation(ctx, request, params)
+// GET /app/rides/{ride_id}/payment
+func (c *Client) AppGetRidePayment(ctx context.Context, params AppGetRidePaymentParams) (AppGetRidePaymentRes, error) {
+	res, err := c.sendAppGetRidePayment(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendAppPostRideEvaluation(ctx context.Context, request OptAppPostRideEvaluationReq, params AppPostRideEvaluationParams) (res AppPostRideEvaluationRes, err error) {
+func (c *Client) sendAppGetRidePayment(ctx context.Context, params AppGetRidePaymentParams) (res AppGetRidePaymentRes, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [3]string
@@ -398,16 +594,13 @@ func (c *Client) sendAppPostRideEvaluation(ctx context.Context, request OptAppPo
 		}
 		pathParts[1] = encoded
 	}
-	pathParts[2] = "/evaluation"
+	pathParts[2] = "/payment"
 	uri.AddPathParts(u, pathParts[:]...)
 
-	r, err := ht.NewRequest(ctx, "POST", u)
+	r, err := ht.NewRequest(ctx, "GET", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeAppPostRideEvaluationRequest(request, r); err != nil {
-		return res, errors.Wrap(err, "encode request")
-	}
 
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
@@ -415,7 +608,7 @@ func (c *Client) sendAppPostRideEvaluation(ctx context.Context, request OptAppPo
 	}
 	defer resp.Body.Close()
 
-	result, err := decodeAppPostRideEvaluationResponse(resp)
+	result, err := decodeAppGetRidePaymentResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -423,30 +616,27 @@ func (c *Client) sendAppPostRideEvaluation(ctx context.Context, request OptAppPo
 	return result, nil
 }
 
-// AppPostRides invokes app-post-rides operation.
+// AppGetRides invokes app-get-rides operation.
 //
-// ユーザーが配車を要求する.
+// ユーザーが完了済みのライド一覧と、マッチングを待っている予約済みのライド一覧を取得する.
 //
-// POST /app/rides
-func (c *Client) AppPostRides(ctx context.Context, request OptAppPostRidesReq) (AppPostRidesRes, error) {
-	res, err := c.sendAppPostRides(ctx, request)
+// GET /app/rides
+func (c *Client) AppGetRides(ctx context.Context) (*AppGetRidesOK, error) {
+	res, err := c.sendAppGetRides(ctx)
 	return res, err
 }
 
-func (c *Client) sendAppPostRides(ctx context.Context, request OptAppPostRidesReq) (res AppPostRidesRes, err error) {
+func (c *Client) sendAppGetRides(ctx context.Context) (res *AppGetRidesOK, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
 	pathParts[0] = "/app/rides"
 	uri.AddPathParts(u, pathParts[:]...)
 
-	r, err := ht.NewRequest(ctx, "POST", u)
+	r, err := ht.NewRequest(ctx, "GET", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeAppPostRidesRequest(request, r); err != nil {
-		return res, errors.Wrap(err, "encode request")
-	}
 
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
@@ -454,7 +644,7 @@ func (c *Client) sendAppPostRides(ctx context.Context, request OptAppPostRidesRe
 	}
 	defer resp.Body.Close()
 
-	result, err := decodeAppPostRidesResponse(resp)
+	result, err := decodeAppGetRidesResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -462,28 +652,48 @@ func (c *Client) sendAppPostRides(ctx context.Context, request OptAppPostRidesRe
 	return result, nil
 }
 
-// AppPostRidesEstimatedFare invokes app-post-rides-estimated-fare operation.
+// AppPatchRide invokes app-patch-ride operation.
 //
-// ライドの運賃を見積もる.
+// MATCHINGになる前(SCHEDULED)のライドの配車日時・配車位置・目的地を変更できる。指定しなかった項目は変更しない
+// 運賃は作成時のサージ倍率とクーポンを使って求め直す
+// 配車日時をマッチングを始める時間まで早めた場合は、すぐにMATCHINGになる.
 //
-// POST /app/rides/estimated-fare
-func (c *Client) AppPostRidesEstimatedFare(ctx context.Context, request OptAppPostRidesEstimatedFareReq) (AppPostRidesEstimatedFareRes, error) {
-	res, err := c.sendAppPostRidesEstimatedFare(ctx, request)
+// PATCH /app/rides/{ride_id}
+func (c *Client) AppPatchRide(ctx context.Context, request OptAppPatchRideReq, params AppPatchRideParams) (AppPatchRideRes, error) {
+	res, err := c.sendAppPatchRide(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendAppPostRidesEstimatedFare(ctx context.Context, request OptAppPostRidesEstimatedFareReq) (res AppPostRidesEstimatedFareRes, err error) {
+func (c *Client) sendAppPatchRide(ctx context.Context, request OptAppPatchRideReq, params AppPatchRideParams) (res AppPatchRideRes, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/app/rides/estimated-fare"
+	var pathParts [2]string
+	pathParts[0] = "/app/rides/"
+	{
+		// Encode "ride_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "ride_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.RideID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
 	uri.AddPathParts(u, pathParts[:]...)
 
-	r, err := ht.NewRequest(ctx, "POST", u)
+	r, err := ht.NewRequest(ctx, "PATCH", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeAppPostRidesEstimatedFareRequest(request, r); err != nil {
+	if err := encodeAppPatchRideRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -493,7 +703,7 @@ func (c *Client) sendAppPostRidesEstimatedFare(ctx context.Context, request OptA
 	}
 	defer resp.Body.Close()
 
-	result, err := decodeAppPostRidesEstimatedFareResponse(resp)
+	result, err := decodeAppPatchRideResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -501,28 +711,29 @@ func (c *Client) sendAppPostRidesEstimatedFare(ctx context.Context, request OptA
 	return result, nil
 }
 
-// AppPostUsers invokes app-post-users operation.
+// AppPostCoupons invokes app-post-coupons operation.
 //
-// ユーザーが会員登録を行う.
+// 期間中のコード入力用(CODE)キャンペーンのうち、code_prefixが入力したコードと一致するもののクーポンを受け取る
+// 受け取ったクーポンは、キャンペーンの優先度が高いものから、同じ優先度なら受け取った順にライドに使われる.
 //
-// POST /app/users
-func (c *Client) AppPostUsers(ctx context.Context, request OptAppPostUsersReq) (AppPostUsersRes, error) {
-	res, err := c.sendAppPostUsers(ctx, request)
+// POST /app/coupons
+func (c *Client) AppPostCoupons(ctx context.Context, request OptAppPostCouponsReq) (AppPostCouponsRes, error) {
+	res, err := c.sendAppPostCoupons(ctx, request)
 	return res, err
 }
 
-func (c *Client) sendAppPostUsers(ctx context.Context, request OptAppPostUsersReq) (res AppPostUsersRes, err error) {
+func (c *Client) sendAppPostCoupons(ctx context.Context, request OptAppPostCouponsReq) (res AppPostCouponsRes, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/app/users"
+	pathParts[0] = "/app/coupons"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeAppPostUsersRequest(request, r); err != nil {
+	if err := encodeAppPostCouponsRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -532,7 +743,7 @@ func (c *Client) sendAppPostUsers(ctx context.Context, request OptAppPostUsersRe
 	}
 	defer resp.Body.Close()
 
-	result, err := decodeAppPostUsersResponse(resp)
+	result, err := decodeAppPostCouponsResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -540,24 +751,43 @@ func (c *Client) sendAppPostUsers(ctx context.Context, request OptAppPostUsersRe
 	return result, nil
 }
 
-// ChairGetNotification invokes chair-get-notification operation.
+// AppPostPaymentMethodDefault invokes app-post-payment-method-default operation.
 //
-// 自分に割り当てられた最新のライドの状態を取得・通知する.
+// 以降に作成するライドで使われる。作成済みのライドの決済トークンは変わらない.
 //
-// GET /chair/notification
-func (c *Client) ChairGetNotification(ctx context.Context) (*ChairGetNotificationOK, error) {
-	res, err := c.sendChairGetNotification(ctx)
+// POST /app/payment-methods/{payment_method_id}/default
+func (c *Client) AppPostPaymentMethodDefault(ctx context.Context, params AppPostPaymentMethodDefaultParams) (AppPostPaymentMethodDefaultRes, error) {
+	res, err := c.sendAppPostPaymentMethodDefault(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendChairGetNotification(ctx context.Context) (res *ChairGetNotificationOK, err error) {
+func (c *Client) sendAppPostPaymentMethodDefault(ctx context.Context, params AppPostPaymentMethodDefaultParams) (res AppPostPaymentMethodDefaultRes, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/chair/notification"
+	var pathParts [3]string
+	pathParts[0] = "/app/payment-methods/"
+	{
+		// Encode "payment_method_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "payment_method_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.PaymentMethodID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/default"
 	uri.AddPathParts(u, pathParts[:]...)
 
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
@@ -568,7 +798,7 @@ func (c *Client) sendChairGetNotification(ctx context.Context) (res *ChairGetNot
 	}
 	defer resp.Body.Close()
 
-	result, err := decodeChairGetNotificationResponse(resp)
+	result, err := decodeAppPostPaymentMethodDefaultResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -576,28 +806,28 @@ func (c *Client) sendChairGetNotification(ctx context.Context) (res *ChairGetNot
 	return result, nil
 }
 
-// ChairPostActivity invokes chair-post-activity operation.
+// AppPostPaymentMethods invokes app-post-payment-methods operation.
 //
-// 椅子が配車受付を開始・停止する.
+// 決済トークンの登録.
 //
-// POST /chair/activity
-func (c *Client) ChairPostActivity(ctx context.Context, request OptChairPostActivityReq) error {
-	_, err := c.sendChairPostActivity(ctx, request)
-	return err
+// POST /app/payment-methods
+func (c *Client) AppPostPaymentMethods(ctx context.Context, request OptAppPostPaymentMethodsReq) (AppPostPaymentMethodsRes, error) {
+	res, err := c.sendAppPostPaymentMethods(ctx, request)
+	return res, err
 }
 
-func (c *Client) sendChairPostActivity(ctx context.Context, request OptChairPostActivityReq) (res *ChairPostActivityNoContent, err error) {
+func (c *Client) sendAppPostPaymentMethods(ctx context.Context, request OptAppPostPaymentMethodsReq) (res AppPostPaymentMethodsRes, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/chair/activity"
+	pathParts[0] = "/app/payment-methods"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeChairPostActivityRequest(request, r); err != nil {
+	if err := encodeAppPostPaymentMethodsRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -607,7 +837,7 @@ func (c *Client) sendChairPostActivity(ctx context.Context, request OptChairPost
 	}
 	defer resp.Body.Close()
 
-	result, err := decodeChairPostActivityResponse(resp)
+	result, err := decodeAppPostPaymentMethodsResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -615,29 +845,48 @@ func (c *Client) sendChairPostActivity(ctx context.Context, request OptChairPost
 	return result, nil
 }
 
-// ChairPostChairs invokes chair-post-chairs operation.
+// AppPostRideCancel invokes app-post-ride-cancel operation.
 //
-// オーナーが椅子の登録を行う.
+// 乗車する前(SCHEDULED, MATCHING, ENROUTE, PICKUP)のライドをキャンセルできる
+// 椅子が乗車位置に向かい始めた後(ENROUTE,
+// PICKUP)のキャンセルにはキャンセル料がかかり、向かっていた椅子の売上になる
+// ライドに使っていたクーポンは次のライドで使えるようになる.
 //
-// POST /chair/chairs
-func (c *Client) ChairPostChairs(ctx context.Context, request OptChairPostChairsReq) (*ChairPostChairsCreatedHeaders, error) {
-	res, err := c.sendChairPostChairs(ctx, request)
+// POST /app/rides/{ride_id}/cancel
+func (c *Client) AppPostRideCancel(ctx context.Context, params AppPostRideCancelParams) (AppPostRideCancelRes, error) {
+	res, err := c.sendAppPostRideCancel(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendChairPostChairs(ctx context.Context, request OptChairPostChairsReq) (res *ChairPostChairsCreatedHeaders, err error) {
+func (c *Client) sendAppPostRideCancel(ctx context.Context, params AppPostRideCancelParams) (res AppPostRideCancelRes, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/chair/chairs"
-	uri.AddPathParts(u, pathParts[:]...)
-
-	r, err := ht.NewRequest(ctx, "POST", u)
-	if err != nil {
-		return res, errors.Wrap(err, "create request")
+	var pathParts [3]string
+	pathParts[0] = "/app/rides/"
+	{
+		// Encode "ride_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "ride_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.RideID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
 	}
-	if err := encodeChairPostChairsRequest(request, r); err != nil {
-		return res, errors.Wrap(err, "encode request")
+	pathParts[2] = "/cancel"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
 	}
 
 	resp, err := c.cfg.Client.Do(r)
@@ -646,7 +895,7 @@ func (c *Client) sendChairPostChairs(ctx context.Context, request OptChairPostCh
 	}
 	defer resp.Body.Close()
 
-	result, err := decodeChairPostChairsResponse(resp)
+	result, err := decodeAppPostRideCancelResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -654,28 +903,48 @@ func (c *Client) sendChairPostChairs(ctx context.Context, request OptChairPostCh
 	return result, nil
 }
 
-// ChairPostCoordinate invokes chair-post-coordinate operation.
+// AppPostRideEvaluation invokes app-post-ride-evaluation operation.
 //
-// 椅子が自身の位置情報を送信する.
+// 決済はレスポンスを返すまでに社内の決済マイクロサービスへ送られる。ISUCON_ASYNC_PAYMENTを指定した実装では、評価の完了後にバックグラウンドで送られる。決済の状況は`GET /app/rides/{ride_id}/payment`で確認できる
+// tipを指定すると、運賃とは別の決済として椅子にチップを払える.
 //
-// POST /chair/coordinate
-func (c *Client) ChairPostCoordinate(ctx context.Context, request OptCoordinate) (*ChairPostCoordinateOK, error) {
-	res, err := c.sendChairPostCoordinate(ctx, request)
+// POST /app/rides/{ride_id}/evaluation
+func (c *Client) AppPostRideEvaluation(ctx context.Context, request OptAppPostRideEvaluationReq, params AppPostRideEvaluationParams) (AppPostRideEvaluationRes, error) {
+	res, err := c.sendAppPostRideEvaluation(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendChairPostCoordinate(ctx context.Context, request OptCoordinate) (res *ChairPostCoordinateOK, err error) {
+func (c *Client) sendAppPostRideEvaluation(ctx context.Context, request OptAppPostRideEvaluationReq, params AppPostRideEvaluationParams) (res AppPostRideEvaluationRes, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/chair/coordinate"
+	var pathParts [3]string
+	pathParts[0] = "/app/rides/"
+	{
+		// Encode "ride_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "ride_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.RideID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/evaluation"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeChairPostCoordinateRequest(request, r); err != nil {
+	if err := encodeAppPostRideEvaluationRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -685,7 +954,7 @@ func (c *Client) sendChairPostCoordinate(ctx context.Context, request OptCoordin
 	}
 	defer resp.Body.Close()
 
-	result, err := decodeChairPostCoordinateResponse(resp)
+	result, err := decodeAppPostRideEvaluationResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -693,47 +962,34 @@ func (c *Client) sendChairPostCoordinate(ctx context.Context, request OptCoordin
 	return result, nil
 }
 
-// ChairPostRideStatus invokes chair-post-ride-status operation.
+// AppPostRides invokes app-post-rides operation.
 //
-// 椅子がライドのステータスを更新する.
+// ユーザーがクーポンを所有している場合、自動で利用する
+// サージ倍率は配車要求を受け付けた時点で確定し、以降の運賃・売上・決済額の計算にはこの倍率を使う
+// pooledを指定すると相乗りを希望できる。同じ方向に向かう相乗りのライドを運んでいる椅子が割り当てられることがある
+// 相乗りの割引は実際に相乗りになった時点で運賃に反映されるので、レスポンスの運賃は割引前のもの
+// stopsを指定すると経由地を順に回ってから目的地に向かう。運賃は配車位置から経由地を順に回って目的地に着くまでの距離で求める
+// scheduled_atを指定すると配車日時を予約できる。予約したライドはSCHEDULEDで作成され、配車日時の一定時間前(ISUCON_SCHEDULED_RIDE_LEAD_TIME)になるとMATCHINGになる
+// payment_method_idを指定すると、運賃やキャンセル料をその決済トークンで支払う.
 //
-// POST /chair/rides/{ride_id}/status
-func (c *Client) ChairPostRideStatus(ctx context.Context, request OptChairPostRideStatusReq, params ChairPostRideStatusParams) (ChairPostRideStatusRes, error) {
-	res, err := c.sendChairPostRideStatus(ctx, request, params)
+// POST /app/rides
+func (c *Client) AppPostRides(ctx context.Context, request OptAppPostRidesReq) (AppPostRidesRes, error) {
+	res, err := c.sendAppPostRides(ctx, request)
 	return res, err
 }
 
-func (c *Client) sendChairPostRideStatus(ctx context.Context, request OptChairPostRideStatusReq, params ChairPostRideStatusParams) (res ChairPostRideStatusRes, err error) {
+func (c *Client) sendAppPostRides(ctx context.Context, request OptAppPostRidesReq) (res AppPostRidesRes, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [3]string
-	pathParts[0] = "/chair/rides/"
-	{
-		// Encode "ride_id" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "ride_id",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.RideID))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		pathParts[1] = encoded
-	}
-	pathParts[2] = "/status"
+	var pathParts [1]string
+	pathParts[0] = "/app/rides"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeChairPostRideStatusRequest(request, r); err != nil {
+	if err := encodeAppPostRidesRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -743,7 +999,7 @@ func (c *Client) sendChairPostRideStatus(ctx context.Context, request OptChairPo
 	}
 	defer resp.Body.Close()
 
-	result, err := decodeChairPostRideStatusResponse(resp)
+	result, err := decodeAppPostRidesResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -751,27 +1007,30 @@ func (c *Client) sendChairPostRideStatus(ctx context.Context, request OptChairPo
 	return result, nil
 }
 
-// InternalGetMatching invokes internal-get-matching operation.
+// AppPostRidesEstimatedFare invokes app-post-rides-estimated-fare operation.
 //
-// ライドのマッチングを行う.
+// 配車位置の周辺で椅子を待っているライドが空いている椅子より多い場合、サージ倍率によって距離に応じた運賃が割り増しされる.
 //
-// GET /internal/matching
-func (c *Client) InternalGetMatching(ctx context.Context) error {
-	_, err := c.sendInternalGetMatching(ctx)
-	return err
+// POST /app/rides/estimated-fare
+func (c *Client) AppPostRidesEstimatedFare(ctx context.Context, request OptAppPostRidesEstimatedFareReq) (AppPostRidesEstimatedFareRes, error) {
+	res, err := c.sendAppPostRidesEstimatedFare(ctx, request)
+	return res, err
 }
 
-func (c *Client) sendInternalGetMatching(ctx context.Context) (res *InternalGetMatchingNoContent, err error) {
+func (c *Client) sendAppPostRidesEstimatedFare(ctx context.Context, request OptAppPostRidesEstimatedFareReq) (res AppPostRidesEstimatedFareRes, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/internal/matching"
+	pathParts[0] = "/app/rides/estimated-fare"
 	uri.AddPathParts(u, pathParts[:]...)
 
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodeAppPostRidesEstimatedFareRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
@@ -779,7 +1038,7 @@ func (c *Client) sendInternalGetMatching(ctx context.Context) (res *InternalGetM
 	}
 	defer resp.Body.Close()
 
-	result, err := decodeInternalGetMatchingResponse(resp)
+	result, err := decodeAppPostRidesEstimatedFareResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -787,27 +1046,30 @@ func (c *Client) sendInternalGetMatching(ctx context.Context) (res *InternalGetM
 	return result, nil
 }
 
-// OwnerGetChairs invokes owner-get-chairs operation.
+// AppPostUsers invokes app-post-users operation.
 //
-// 椅子のオーナーが管理している椅子の一覧を取得する.
+// 招待コードを用いて登録した場合は、招待クーポンを付与する.
 //
-// GET /owner/chairs
-func (c *Client) OwnerGetChairs(ctx context.Context) (*OwnerGetChairsOK, error) {
-	res, err := c.sendOwnerGetChairs(ctx)
+// POST /app/users
+func (c *Client) AppPostUsers(ctx context.Context, request OptAppPostUsersReq) (AppPostUsersRes, error) {
+	res, err := c.sendAppPostUsers(ctx, request)
 	return res, err
 }
 
-func (c *Client) sendOwnerGetChairs(ctx context.Context) (res *OwnerGetChairsOK, err error) {
+func (c *Client) sendAppPostUsers(ctx context.Context, request OptAppPostUsersReq) (res AppPostUsersRes, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/owner/chairs"
+	pathParts[0] = "/app/users"
 	uri.AddPathParts(u, pathParts[:]...)
 
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodeAppPostUsersRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
@@ -815,7 +1077,7 @@ func (c *Client) sendOwnerGetChairs(ctx context.Context) (res *OwnerGetChairsOK,
 	}
 	defer resp.Body.Close()
 
-	result, err := decodeOwnerGetChairsResponse(resp)
+	result, err := decodeAppPostUsersResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -823,21 +1085,21 @@ func (c *Client) sendOwnerGetChairs(ctx context.Context) (res *OwnerGetChairsOK,
 	return result, nil
 }
 
-// OwnerGetSales invokes owner-get-sales operation.
+// ChairGetEarnings invokes chair-get-earnings operation.
 //
-// 椅子のオーナーが指定期間の全体・椅子ごと・モデルごとの売上情報を取得する.
+// オーナーの売上情報と同じく、完了したライドの運賃と椅子が乗車位置に向かっていたライドのキャンセル料を売上に含める.
 //
-// GET /owner/sales
-func (c *Client) OwnerGetSales(ctx context.Context, params OwnerGetSalesParams) (*OwnerGetSalesOK, error) {
-	res, err := c.sendOwnerGetSales(ctx, params)
+// GET /chair/earnings
+func (c *Client) ChairGetEarnings(ctx context.Context, params ChairGetEarningsParams) (ChairGetEarningsRes, error) {
+	res, err := c.sendChairGetEarnings(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendOwnerGetSales(ctx context.Context, params OwnerGetSalesParams) (res *OwnerGetSalesOK, err error) {
+func (c *Client) sendChairGetEarnings(ctx context.Context, params ChairGetEarningsParams) (res ChairGetEarningsRes, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/owner/sales"
+	pathParts[0] = "/chair/earnings"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	q := uri.NewQueryEncoder()
@@ -888,7 +1150,7 @@ func (c *Client) sendOwnerGetSales(ctx context.Context, params OwnerGetSalesPara
 	}
 	defer resp.Body.Close()
 
-	result, err := decodeOwnerGetSalesResponse(resp)
+	result, err := decodeChairGetEarningsResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -896,28 +1158,139 @@ func (c *Client) sendOwnerGetSales(ctx context.Context, params OwnerGetSalesPara
 	return result, nil
 }
 
-// OwnerPostOwners invokes owner-post-owners operation.
+// ChairGetNotification invokes chair-get-notification operation.
 //
-// 椅子のオーナーが会員登録を行う.
+// 自分に割り当てられた最新のライドの状態を取得・通知する
+// `Accept: text/event-stream` を指定した場合はServer-Sent
+// Eventsで、接続時点の状態と以降の状態の変化を1つずつ通知する.
 //
-// POST /owner/owners
-func (c *Client) OwnerPostOwners(ctx context.Context, request OptOwnerPostOwnersReq) (OwnerPostOwnersRes, error) {
-	res, err := c.sendOwnerPostOwners(ctx, request)
+// GET /chair/notification
+func (c *Client) ChairGetNotification(ctx context.Context) (*ChairGetNotificationOKApplicationJSON, error) {
+	res, err := c.sendChairGetNotification(ctx)
 	return res, err
 }
 
-func (c *Client) sendOwnerPostOwners(ctx context.Context, request OptOwnerPostOwnersReq) (res OwnerPostOwnersRes, err error) {
+func (c *Client) sendChairGetNotification(ctx context.Context) (res *ChairGetNotificationOKApplicationJSON, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/owner/owners"
+	pathParts[0] = "/chair/notification"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeChairGetNotificationResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ChairGetRides invokes chair-get-rides operation.
+//
+// 椅子が自身に割り当てられたライドの履歴を新しい順に取得する.
+//
+// GET /chair/rides
+func (c *Client) ChairGetRides(ctx context.Context, params ChairGetRidesParams) (ChairGetRidesRes, error) {
+	res, err := c.sendChairGetRides(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendChairGetRides(ctx context.Context, params ChairGetRidesParams) (res ChairGetRidesRes, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/chair/rides"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "limit" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Limit.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "cursor" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "cursor",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Cursor.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeChairGetRidesResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ChairPostActivity invokes chair-post-activity operation.
+//
+// 椅子が配車受付を開始・停止する.
+//
+// POST /chair/activity
+func (c *Client) ChairPostActivity(ctx context.Context, request OptChairPostActivityReq) (ChairPostActivityRes, error) {
+	res, err := c.sendChairPostActivity(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendChairPostActivity(ctx context.Context, request OptChairPostActivityReq) (res ChairPostActivityRes, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/chair/activity"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeOwnerPostOwnersRequest(request, r); err != nil {
+	if err := encodeChairPostActivityRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -927,7 +1300,1320 @@ func (c *Client) sendOwnerPostOwners(ctx context.Context, request OptOwnerPostOw
 	}
 	defer resp.Body.Close()
 
-	result, err := decodeOwnerPostOwnersResponse(resp)
+	result, err := decodeChairPostActivityResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ChairPostChairs invokes chair-post-chairs operation.
+//
+// オーナーが椅子の登録を行う.
+//
+// POST /chair/chairs
+func (c *Client) ChairPostChairs(ctx context.Context, request OptChairPostChairsReq) (*ChairPostChairsCreatedHeaders, error) {
+	res, err := c.sendChairPostChairs(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendChairPostChairs(ctx context.Context, request OptChairPostChairsReq) (res *ChairPostChairsCreatedHeaders, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/chair/chairs"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeChairPostChairsRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeChairPostChairsResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ChairPostCoordinate invokes chair-post-coordinate operation.
+//
+// 椅子が自身の位置情報を送信する.
+//
+// POST /chair/coordinate
+func (c *Client) ChairPostCoordinate(ctx context.Context, request OptCoordinate) (*ChairPostCoordinateOK, error) {
+	res, err := c.sendChairPostCoordinate(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendChairPostCoordinate(ctx context.Context, request OptCoordinate) (res *ChairPostCoordinateOK, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/chair/coordinate"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeChairPostCoordinateRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeChairPostCoordinateResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ChairPostRideEvaluation invokes chair-post-ride-evaluation operation.
+//
+// 椅子が完了したライドのユーザーを評価する.
+//
+// POST /chair/rides/{ride_id}/evaluation
+func (c *Client) ChairPostRideEvaluation(ctx context.Context, request OptChairPostRideEvaluationReq, params ChairPostRideEvaluationParams) (ChairPostRideEvaluationRes, error) {
+	res, err := c.sendChairPostRideEvaluation(ctx, request, params)
+	return res, err
+}
+
+func (c *Client) sendChairPostRideEvaluation(ctx context.Context, request OptChairPostRideEvaluationReq, params ChairPostRideEvaluationParams) (res ChairPostRideEvaluationRes, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/chair/rides/"
+	{
+		// Encode "ride_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "ride_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.RideID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/evaluation"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeChairPostRideEvaluationRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeChairPostRideEvaluationResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ChairPostRideStatus invokes chair-post-ride-status operation.
+//
+// 椅子がライドのステータスを更新する.
+//
+// POST /chair/rides/{ride_id}/status
+func (c *Client) ChairPostRideStatus(ctx context.Context, request OptChairPostRideStatusReq, params ChairPostRideStatusParams) (ChairPostRideStatusRes, error) {
+	res, err := c.sendChairPostRideStatus(ctx, request, params)
+	return res, err
+}
+
+func (c *Client) sendChairPostRideStatus(ctx context.Context, request OptChairPostRideStatusReq, params ChairPostRideStatusParams) (res ChairPostRideStatusRes, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/chair/rides/"
+	{
+		// Encode "ride_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "ride_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.RideID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/status"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeChairPostRideStatusRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeChairPostRideStatusResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// InternalGetCouponCampaigns invokes internal-get-coupon-campaigns operation.
+//
+// *内部からのみアクセス可能としている*
+// ライドに適用する優先度の高い順に返す.
+//
+// GET /internal/coupon-campaigns
+func (c *Client) InternalGetCouponCampaigns(ctx context.Context) (*InternalGetCouponCampaignsOK, error) {
+	res, err := c.sendInternalGetCouponCampaigns(ctx)
+	return res, err
+}
+
+func (c *Client) sendInternalGetCouponCampaigns(ctx context.Context) (res *InternalGetCouponCampaignsOK, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/internal/coupon-campaigns"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeInternalGetCouponCampaignsResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// InternalGetMatching invokes internal-get-matching operation.
+//
+// *内部からのみアクセス可能としている*.
+//
+// GET /internal/matching
+func (c *Client) InternalGetMatching(ctx context.Context) error {
+	_, err := c.sendInternalGetMatching(ctx)
+	return err
+}
+
+func (c *Client) sendInternalGetMatching(ctx context.Context) (res *InternalGetMatchingNoContent, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/internal/matching"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeInternalGetMatchingResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// InternalPostCouponCampaigns invokes internal-post-coupon-campaigns operation.
+//
+// *内部からのみアクセス可能としている*。キャンペーンは全てのユーザーに影響するので、オーナーからは作成できない
+// SIGNUP・INVITEE・INVITERのキャンペーンは、それぞれの種類で期間中の最も優先度の高いものが登録時・招待時に使われる.
+//
+// POST /internal/coupon-campaigns
+func (c *Client) InternalPostCouponCampaigns(ctx context.Context, request OptInternalPostCouponCampaignsReq) (InternalPostCouponCampaignsRes, error) {
+	res, err := c.sendInternalPostCouponCampaigns(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendInternalPostCouponCampaigns(ctx context.Context, request OptInternalPostCouponCampaignsReq) (res InternalPostCouponCampaignsRes, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/internal/coupon-campaigns"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeInternalPostCouponCampaignsRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeInternalPostCouponCampaignsResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// OwnerGetChairRides invokes owner-get-chair-rides operation.
+//
+// 椅子のオーナーが管理している椅子のライドの履歴を取得する.
+//
+// GET /owner/chairs/{chair_id}/rides
+func (c *Client) OwnerGetChairRides(ctx context.Context, params OwnerGetChairRidesParams) (OwnerGetChairRidesRes, error) {
+	res, err := c.sendOwnerGetChairRides(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendOwnerGetChairRides(ctx context.Context, params OwnerGetChairRidesParams) (res OwnerGetChairRidesRes, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/owner/chairs/"
+	{
+		// Encode "chair_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "chair_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.ChairID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/rides"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "limit" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Limit.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "cursor" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "cursor",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Cursor.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeOwnerGetChairRidesResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// OwnerGetChairTrack invokes owner-get-chair-track operation.
+//
+// 椅子のオーナーが管理している椅子の、指定期間の移動の軌跡を取得する.
+//
+// GET /owner/chairs/{chair_id}/track
+func (c *Client) OwnerGetChairTrack(ctx context.Context, params OwnerGetChairTrackParams) (OwnerGetChairTrackRes, error) {
+	res, err := c.sendOwnerGetChairTrack(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendOwnerGetChairTrack(ctx context.Context, params OwnerGetChairTrackParams) (res OwnerGetChairTrackRes, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/owner/chairs/"
+	{
+		// Encode "chair_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "chair_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.ChairID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/track"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "since" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "since",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Since.Get(); ok {
+				return e.EncodeValue(conv.Int64ToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "until" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "until",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Until.Get(); ok {
+				return e.EncodeValue(conv.Int64ToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "max_points" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "max_points",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.MaxPoints.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeOwnerGetChairTrackResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// OwnerGetChairs invokes owner-get-chairs operation.
+//
+// 椅子のオーナーが管理している椅子の一覧を取得する.
+//
+// GET /owner/chairs
+func (c *Client) OwnerGetChairs(ctx context.Context) (*OwnerGetChairsOK, error) {
+	res, err := c.sendOwnerGetChairs(ctx)
+	return res, err
+}
+
+func (c *Client) sendOwnerGetChairs(ctx context.Context) (res *OwnerGetChairsOK, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/owner/chairs"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeOwnerGetChairsResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// OwnerGetFailedPayments invokes owner-get-failed-payments operation.
+//
+// リトライし尽くした(FAILED)、もしくは決済マイクロサービスに拒否された(REJECTED)決済を更新日時の降順で返す.
+//
+// GET /owner/failed-payments
+func (c *Client) OwnerGetFailedPayments(ctx context.Context) (*OwnerGetFailedPaymentsOK, error) {
+	res, err := c.sendOwnerGetFailedPayments(ctx)
+	return res, err
+}
+
+func (c *Client) sendOwnerGetFailedPayments(ctx context.Context) (res *OwnerGetFailedPaymentsOK, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/owner/failed-payments"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeOwnerGetFailedPaymentsResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// OwnerGetRideRefunds invokes owner-get-ride-refunds operation.
+//
+// 椅子のオーナーが管理している椅子のライドの返金を登録順に取得する.
+//
+// GET /owner/rides/{ride_id}/refunds
+func (c *Client) OwnerGetRideRefunds(ctx context.Context, params OwnerGetRideRefundsParams) (OwnerGetRideRefundsRes, error) {
+	res, err := c.sendOwnerGetRideRefunds(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendOwnerGetRideRefunds(ctx context.Context, params OwnerGetRideRefundsParams) (res OwnerGetRideRefundsRes, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/owner/rides/"
+	{
+		// Encode "ride_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "ride_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.RideID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/refunds"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeOwnerGetRideRefundsResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// OwnerGetRideRoute invokes owner-get-ride-route operation.
+//
+// 到着する前にキャンセルされたライドはキャンセルまで、進行中のライドは現在までの軌跡を返す
+// 配車位置へ向かい始めていないライドでは軌跡は空になる.
+//
+// GET /owner/chairs/{chair_id}/rides/{ride_id}/route
+func (c *Client) OwnerGetRideRoute(ctx context.Context, params OwnerGetRideRouteParams) (OwnerGetRideRouteRes, error) {
+	res, err := c.sendOwnerGetRideRoute(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendOwnerGetRideRoute(ctx context.Context, params OwnerGetRideRouteParams) (res OwnerGetRideRouteRes, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [5]string
+	pathParts[0] = "/owner/chairs/"
+	{
+		// Encode "chair_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "chair_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.ChairID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/rides/"
+	{
+		// Encode "ride_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "ride_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.RideID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[3] = encoded
+	}
+	pathParts[4] = "/route"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "max_points" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "max_points",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.MaxPoints.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeOwnerGetRideRouteResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// OwnerGetSales invokes owner-get-sales operation.
+//
+// 完了したライドの運賃に加えて、椅子が乗車位置に向かっていたライドのキャンセル料も売上に含める
+// 相乗りしたライドは、それぞれのライドの相乗りの割引後の運賃が運んだ椅子の売上になる
+// 返金は、決済マイクロサービスで返金が済んだものだけを返金を登録した日時の売上から差し引く。そのため期間によっては売上が負の値になる
+// チップは売上に含めず、期間中に完了したライドのチップを別に集計する.
+//
+// GET /owner/sales
+func (c *Client) OwnerGetSales(ctx context.Context, params OwnerGetSalesParams) (*OwnerGetSalesOK, error) {
+	res, err := c.sendOwnerGetSales(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendOwnerGetSales(ctx context.Context, params OwnerGetSalesParams) (res *OwnerGetSalesOK, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/owner/sales"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "since" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "since",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Since.Get(); ok {
+				return e.EncodeValue(conv.Int64ToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "until" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "until",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Until.Get(); ok {
+				return e.EncodeValue(conv.Int64ToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeOwnerGetSalesResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// OwnerGetSalesExport invokes owner-get-sales-export operation.
+//
+// 完了したライドの運賃・キャンセル料・返金をそれぞれ1行にし、発生日時の順に書き出す。返金は済んだものだけを書き出し、返金の行のsalesは負の値になる
+// 各行の項目はkind (RIDE, CANCELLATION もしくは REFUND), ride_id, chair_id, chair_name,
+// model, occurred_at (UNIXミリ秒), sales, evaluation
+// CSVでは1行目に項目名を書き出し、評価が無い行のevaluationは空になる.
+//
+// GET /owner/sales/export
+func (c *Client) OwnerGetSalesExport(ctx context.Context, params OwnerGetSalesExportParams) (OwnerGetSalesExportRes, error) {
+	res, err := c.sendOwnerGetSalesExport(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendOwnerGetSalesExport(ctx context.Context, params OwnerGetSalesExportParams) (res OwnerGetSalesExportRes, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/owner/sales/export"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "since" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "since",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Since.Get(); ok {
+				return e.EncodeValue(conv.Int64ToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "until" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "until",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Until.Get(); ok {
+				return e.EncodeValue(conv.Int64ToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "format" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "format",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Format.Get(); ok {
+				return e.EncodeValue(conv.StringToString(string(val)))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeOwnerGetSalesExportResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// OwnerGetSalesTimeseries invokes owner-get-sales-timeseries operation.
+//
+// 売上の考え方は`GET
+// /owner/sales`と同じ。キャンセル料や返金は売上に含めるが、ライドの数や評価の平均には含めない
+// 売上もライドも無い集計単位は含まれない.
+//
+// GET /owner/sales/timeseries
+func (c *Client) OwnerGetSalesTimeseries(ctx context.Context, params OwnerGetSalesTimeseriesParams) (OwnerGetSalesTimeseriesRes, error) {
+	res, err := c.sendOwnerGetSalesTimeseries(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendOwnerGetSalesTimeseries(ctx context.Context, params OwnerGetSalesTimeseriesParams) (res OwnerGetSalesTimeseriesRes, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/owner/sales/timeseries"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "since" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "since",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Since.Get(); ok {
+				return e.EncodeValue(conv.Int64ToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "until" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "until",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Until.Get(); ok {
+				return e.EncodeValue(conv.Int64ToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "bucket" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "bucket",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Bucket.Get(); ok {
+				return e.EncodeValue(conv.StringToString(string(val)))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeOwnerGetSalesTimeseriesResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// OwnerPatchChair invokes owner-patch-chair operation.
+//
+// 指定しなかった項目は変更しない。モデルは登録されているモデルにしか変更できない.
+//
+// PATCH /owner/chairs/{chair_id}
+func (c *Client) OwnerPatchChair(ctx context.Context, request OptOwnerPatchChairReq, params OwnerPatchChairParams) (OwnerPatchChairRes, error) {
+	res, err := c.sendOwnerPatchChair(ctx, request, params)
+	return res, err
+}
+
+func (c *Client) sendOwnerPatchChair(ctx context.Context, request OptOwnerPatchChairReq, params OwnerPatchChairParams) (res OwnerPatchChairRes, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/owner/chairs/"
+	{
+		// Encode "chair_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "chair_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.ChairID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "PATCH", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeOwnerPatchChairRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeOwnerPatchChairResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// OwnerPostChairAccessToken invokes owner-post-chair-access-token operation.
+//
+// 以前のアクセストークンは使えなくなる.
+//
+// POST /owner/chairs/{chair_id}/access-token
+func (c *Client) OwnerPostChairAccessToken(ctx context.Context, params OwnerPostChairAccessTokenParams) (OwnerPostChairAccessTokenRes, error) {
+	res, err := c.sendOwnerPostChairAccessToken(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendOwnerPostChairAccessToken(ctx context.Context, params OwnerPostChairAccessTokenParams) (res OwnerPostChairAccessTokenRes, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/owner/chairs/"
+	{
+		// Encode "chair_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "chair_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.ChairID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/access-token"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeOwnerPostChairAccessTokenResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// OwnerPostChairDeactivate invokes owner-post-chair-deactivate operation.
+//
+// 進行中のライドはそのまま続けられる。椅子は自身で再び稼働できる.
+//
+// POST /owner/chairs/{chair_id}/deactivate
+func (c *Client) OwnerPostChairDeactivate(ctx context.Context, params OwnerPostChairDeactivateParams) (OwnerPostChairDeactivateRes, error) {
+	res, err := c.sendOwnerPostChairDeactivate(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendOwnerPostChairDeactivate(ctx context.Context, params OwnerPostChairDeactivateParams) (res OwnerPostChairDeactivateRes, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/owner/chairs/"
+	{
+		// Encode "chair_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "chair_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.ChairID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/deactivate"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeOwnerPostChairDeactivateResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// OwnerPostChairRetire invokes owner-post-chair-retire operation.
+//
+// 引退した椅子はマッチングや周辺の椅子の検索の対象にならず、再び稼働させることもできない
+// 売上やライドの履歴は引退後も残る.
+//
+// POST /owner/chairs/{chair_id}/retire
+func (c *Client) OwnerPostChairRetire(ctx context.Context, params OwnerPostChairRetireParams) (OwnerPostChairRetireRes, error) {
+	res, err := c.sendOwnerPostChairRetire(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendOwnerPostChairRetire(ctx context.Context, params OwnerPostChairRetireParams) (res OwnerPostChairRetireRes, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/owner/chairs/"
+	{
+		// Encode "chair_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "chair_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.ChairID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/retire"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeOwnerPostChairRetireResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// OwnerPostOwners invokes owner-post-owners operation.
+//
+// 椅子のオーナーが会員登録を行う.
+//
+// POST /owner/owners
+func (c *Client) OwnerPostOwners(ctx context.Context, request OptOwnerPostOwnersReq) (OwnerPostOwnersRes, error) {
+	res, err := c.sendOwnerPostOwners(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendOwnerPostOwners(ctx context.Context, request OptOwnerPostOwnersReq) (res OwnerPostOwnersRes, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/owner/owners"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeOwnerPostOwnersRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeOwnerPostOwnersResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// OwnerPostRideRefund invokes owner-post-ride-refund operation.
+//
+// 決済が成功したライドの決済額の全額もしくは一部を返金する。返金額の合計は決済額を超えられない
+// 返金はバックグラウンドで決済マイクロサービスに送り、失敗した場合は決済と同じようにリトライする
+// 返金が済んだら、その額を返金を登録した日時の椅子の売上から差し引く.
+//
+// POST /owner/rides/{ride_id}/refunds
+func (c *Client) OwnerPostRideRefund(ctx context.Context, request OptOwnerPostRideRefundReq, params OwnerPostRideRefundParams) (OwnerPostRideRefundRes, error) {
+	res, err := c.sendOwnerPostRideRefund(ctx, request, params)
+	return res, err
+}
+
+func (c *Client) sendOwnerPostRideRefund(ctx context.Context, request OptOwnerPostRideRefundReq, params OwnerPostRideRefundParams) (res OwnerPostRideRefundRes, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/owner/rides/"
+	{
+		// Encode "ride_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "ride_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.RideID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/refunds"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeOwnerPostRideRefundRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeOwnerPostRideRefundResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -0,0 +1,35 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package api
+
+// setDefaults set default value of fields.
+func (s *AppPostPaymentMethodsReq) setDefaults() {
+	{
+		val := bool(false)
+		s.IsDefault.SetTo(val)
+	}
+}
+
+// setDefaults set default value of fields.
+func (s *AppPostRideEvaluationReq) setDefaults() {
+	{
+		val := int(0)
+		s.Tip.SetTo(val)
+	}
+}
+
+// setDefaults set default value of fields.
+func (s *AppPostRidesEstimatedFareReq) setDefaults() {
+	{
+		val := bool(false)
+		s.Pooled.SetTo(val)
+	}
+}
+
+// setDefaults set default value of fields.
+func (s *AppPostRidesReq) setDefaults() {
+	{
+		val := bool(false)
+		s.Pooled.SetTo(val)
+	}
+}
@@ -1,10 +1,34 @@
 // Code generated by ogen, DO NOT EDIT.
 package api
 
+type AppDeletePaymentMethodRes interface {
+	appDeletePaymentMethodRes()
+}
+
+type AppGetRidePaymentRes interface {
+	appGetRidePaymentRes()
+}
+
+type AppPatchRideRes interface {
+	appPatchRideRes()
+}
+
+type AppPostCouponsRes interface {
+	appPostCouponsRes()
+}
+
+type AppPostPaymentMethodDefaultRes interface {
+	appPostPaymentMethodDefaultRes()
+}
+
 type AppPostPaymentMethodsRes interface {
 	appPostPaymentMethodsRes()
 }
 
+type AppPostRideCancelRes interface {
+	appPostRideCancelRes()
+}
+
 type AppPostRideEvaluationRes interface {
 	appPostRideEvaluationRes()
 }
@@ -21,10 +45,74 @@ type AppPostUsersRes interface {
 	appPostUsersRes()
 }
 
+type ChairGetEarningsRes interface {
+	chairGetEarningsRes()
+}
+
+type ChairGetRidesRes interface {
+	chairGetRidesRes()
+}
+
+type ChairPostActivityRes interface {
+	chairPostActivityRes()
+}
+
+type ChairPostRideEvaluationRes interface {
+	chairPostRideEvaluationRes()
+}
+
 type ChairPostRideStatusRes interface {
 	chairPostRideStatusRes()
 }
 
+type InternalPostCouponCampaignsRes interface {
+	internalPostCouponCampaignsRes()
+}
+
+type OwnerGetChairRidesRes interface {
+	ownerGetChairRidesRes()
+}
+
+type OwnerGetChairTrackRes interface {
+	ownerGetChairTrackRes()
+}
+
+type OwnerGetRideRefundsRes interface {
+	ownerGetRideRefundsRes()
+}
+
+type OwnerGetRideRouteRes interface {
+	ownerGetRideRouteRes()
+}
+
+type OwnerGetSalesExportRes interface {
+	ownerGetSalesExportRes()
+}
+
+type OwnerGetSalesTimeseriesRes interface {
+	ownerGetSalesTimeseriesRes()
+}
+
+type OwnerPatchChairRes interface {
+	ownerPatchChairRes()
+}
+
+type OwnerPostChairAccessTokenRes interface {
+	ownerPostChairAccessTokenRes()
+}
+
+type OwnerPostChairDeactivateRes interface {
+	ownerPostChairDeactivateRes()
+}
+
+type OwnerPostChairRetireRes interface {
+	ownerPostChairRetireRes()
+}
+
 type OwnerPostOwnersRes interface {
 	ownerPostOwnersRes()
 }
+
+type OwnerPostRideRefundRes interface {
+	ownerPostRideRefundRes()
+}
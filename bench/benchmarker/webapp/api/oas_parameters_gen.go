@@ -2,6 +2,12 @@
 
 package api
 
+// AppDeletePaymentMethodParams is parameters of app-delete-payment-method operation.
+type AppDeletePaymentMethodParams struct {
+	// 決済トークンのID.
+	PaymentMethodID string
+}
+
 // AppGetNearbyChairsParams is parameters of app-get-nearby-chairs operation.
 type AppGetNearbyChairsParams struct {
 	// 緯度.
@@ -12,22 +18,156 @@ type AppGetNearbyChairsParams struct {
 	Distance OptInt
 }
 
+// AppGetRidePaymentParams is parameters of app-get-ride-payment operation.
+type AppGetRidePaymentParams struct {
+	// ライドID.
+	RideID string
+}
+
+// AppPatchRideParams is parameters of app-patch-ride operation.
+type AppPatchRideParams struct {
+	// ライドID.
+	RideID string
+}
+
+// AppPostPaymentMethodDefaultParams is parameters of app-post-payment-method-default operation.
+type AppPostPaymentMethodDefaultParams struct {
+	// 決済トークンのID.
+	PaymentMethodID string
+}
+
+// AppPostRideCancelParams is parameters of app-post-ride-cancel operation.
+type AppPostRideCancelParams struct {
+	// ライドID.
+	RideID string
+}
+
 // AppPostRideEvaluationParams is parameters of app-post-ride-evaluation operation.
 type AppPostRideEvaluationParams struct {
 	// ライドID.
 	RideID string
 }
 
+// ChairGetEarningsParams is parameters of chair-get-earnings operation.
+type ChairGetEarningsParams struct {
+	// 開始日時（含む） (UNIXミリ秒).
+	Since OptInt64
+	// 終了日時（含む） (UNIXミリ秒).
+	Until OptInt64
+}
+
+// ChairGetRidesParams is parameters of chair-get-rides operation.
+type ChairGetRidesParams struct {
+	// 1回で取得するライドの数.
+	Limit OptInt
+	// 前回のレスポンスのnext_cursor。指定するとその続きを取得する.
+	Cursor OptString
+}
+
+// ChairPostRideEvaluationParams is parameters of chair-post-ride-evaluation operation.
+type ChairPostRideEvaluationParams struct {
+	// ライドID.
+	RideID string
+}
+
 // ChairPostRideStatusParams is parameters of chair-post-ride-status operation.
 type ChairPostRideStatusParams struct {
 	// ライドID.
 	RideID string
 }
 
+// OwnerGetChairRidesParams is parameters of owner-get-chair-rides operation.
+type OwnerGetChairRidesParams struct {
+	// 椅子ID.
+	ChairID string
+	// 1回で取得するライドの数.
+	Limit OptInt
+	// 前回のレスポンスのnext_cursor。指定するとその続きを取得する.
+	Cursor OptString
+}
+
+// OwnerGetChairTrackParams is parameters of owner-get-chair-track operation.
+type OwnerGetChairTrackParams struct {
+	// 椅子ID.
+	ChairID string
+	// 開始日時（含む） (UNIXミリ秒).
+	Since OptInt64
+	// 終了日時（含む） (UNIXミリ秒).
+	Until OptInt64
+	// 返す点の数の上限。超える場合は始点と終点を残し、軌跡の形を保つように間引く。指定しなければ間引かない.
+	MaxPoints OptInt
+}
+
+// OwnerGetRideRefundsParams is parameters of owner-get-ride-refunds operation.
+type OwnerGetRideRefundsParams struct {
+	// ライドID.
+	RideID string
+}
+
+// OwnerGetRideRouteParams is parameters of owner-get-ride-route operation.
+type OwnerGetRideRouteParams struct {
+	// 椅子ID.
+	ChairID string
+	// ライドID.
+	RideID string
+	// 返す点の数の上限。超える場合は始点と終点を残し、軌跡の形を保つように間引く。指定しなければ間引かない.
+	MaxPoints OptInt
+}
+
 // OwnerGetSalesParams is parameters of owner-get-sales operation.
 type OwnerGetSalesParams struct {
-	// 開始日時（含む）.
+	// 開始日時（含む） (UNIXミリ秒).
+	Since OptInt64
+	// 終了日時（含む） (UNIXミリ秒).
+	Until OptInt64
+}
+
+// OwnerGetSalesExportParams is parameters of owner-get-sales-export operation.
+type OwnerGetSalesExportParams struct {
+	// 開始日時（含む） (UNIXミリ秒).
+	Since OptInt64
+	// 終了日時（含む） (UNIXミリ秒).
+	Until OptInt64
+	// 書き出す形式.
+	Format OptOwnerGetSalesExportFormat
+}
+
+// OwnerGetSalesTimeseriesParams is parameters of owner-get-sales-timeseries operation.
+type OwnerGetSalesTimeseriesParams struct {
+	// 開始日時（含む） (UNIXミリ秒).
 	Since OptInt64
-	// 終了日時（含む）.
+	// 終了日時（含む） (UNIXミリ秒).
 	Until OptInt64
+	// 集計単位。週は月曜日から始まる.
+	Bucket OptOwnerGetSalesTimeseriesBucket
+}
+
+// OwnerPatchChairParams is parameters of owner-patch-chair operation.
+type OwnerPatchChairParams struct {
+	// 椅子ID.
+	ChairID string
+}
+
+// OwnerPostChairAccessTokenParams is parameters of owner-post-chair-access-token operation.
+type OwnerPostChairAccessTokenParams struct {
+	// 椅子ID.
+	ChairID string
+}
+
+// OwnerPostChairDeactivateParams is parameters of owner-post-chair-deactivate operation.
+type OwnerPostChairDeactivateParams struct {
+	// 椅子ID.
+	ChairID string
+}
+
+// OwnerPostChairRetireParams is parameters of owner-post-chair-retire operation.
+type OwnerPostChairRetireParams struct {
+	// 椅子ID.
+	ChairID string
+}
+
+// OwnerPostRideRefundParams is parameters of owner-post-ride-refund operation.
+type OwnerPostRideRefundParams struct {
+	// ライドID.
+	RideID string
 }
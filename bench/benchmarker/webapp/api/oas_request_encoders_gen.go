@@ -11,6 +11,46 @@ import (
 	ht "github.com/ogen-go/ogen/http"
 )
 
+func encodeAppPatchRideRequest(
+	req OptAppPatchRideReq,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	if !req.Set {
+		// Keep request with empty body if value is not set.
+		return nil
+	}
+	e := new(jx.Encoder)
+	{
+		if req.Set {
+			req.Encode(e)
+		}
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
+func encodeAppPostCouponsRequest(
+	req OptAppPostCouponsReq,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	if !req.Set {
+		// Keep request with empty body if value is not set.
+		return nil
+	}
+	e := new(jx.Encoder)
+	{
+		if req.Set {
+			req.Encode(e)
+		}
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
 func encodeAppPostPaymentMethodsRequest(
 	req OptAppPostPaymentMethodsReq,
 	r *http.Request,
@@ -171,6 +211,26 @@ func encodeChairPostCoordinateRequest(
 	return nil
 }
 
+func encodeChairPostRideEvaluationRequest(
+	req OptChairPostRideEvaluationReq,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	if !req.Set {
+		// Keep request with empty body if value is not set.
+		return nil
+	}
+	e := new(jx.Encoder)
+	{
+		if req.Set {
+			req.Encode(e)
+		}
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
 func encodeChairPostRideStatusRequest(
 	req OptChairPostRideStatusReq,
 	r *http.Request,
@@ -191,6 +251,46 @@ func encodeChairPostRideStatusRequest(
 	return nil
 }
 
+func encodeInternalPostCouponCampaignsRequest(
+	req OptInternalPostCouponCampaignsReq,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	if !req.Set {
+		// Keep request with empty body if value is not set.
+		return nil
+	}
+	e := new(jx.Encoder)
+	{
+		if req.Set {
+			req.Encode(e)
+		}
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
+func encodeOwnerPatchChairRequest(
+	req OptOwnerPatchChairReq,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	if !req.Set {
+		// Keep request with empty body if value is not set.
+		return nil
+	}
+	e := new(jx.Encoder)
+	{
+		if req.Set {
+			req.Encode(e)
+		}
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
 func encodeOwnerPostOwnersRequest(
 	req OptOwnerPostOwnersReq,
 	r *http.Request,
@@ -211,6 +311,26 @@ func encodeOwnerPostOwnersRequest(
 	return nil
 }
 
+func encodeOwnerPostRideRefundRequest(
+	req OptOwnerPostRideRefundReq,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	if !req.Set {
+		// Keep request with empty body if value is not set.
+		return nil
+	}
+	e := new(jx.Encoder)
+	{
+		if req.Set {
+			req.Encode(e)
+		}
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
 func encodePostInitializeRequest(
 	req OptPostInitializeReq,
 	r *http.Request,
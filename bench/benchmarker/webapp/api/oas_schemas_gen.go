@@ -3,12 +3,28 @@
 package api
 
 import (
+	"io"
+	"time"
+
 	"github.com/go-faster/errors"
 )
 
+type AppDeletePaymentMethodConflict Error
+
+func (*AppDeletePaymentMethodConflict) appDeletePaymentMethodRes() {}
+
+// AppDeletePaymentMethodNoContent is response for AppDeletePaymentMethod operation.
+type AppDeletePaymentMethodNoContent struct{}
+
+func (*AppDeletePaymentMethodNoContent) appDeletePaymentMethodRes() {}
+
+type AppDeletePaymentMethodNotFound Error
+
+func (*AppDeletePaymentMethodNotFound) appDeletePaymentMethodRes() {}
+
 type AppGetNearbyChairsOK struct {
 	Chairs []AppGetNearbyChairsOKChairsItem `json:"chairs"`
-	// 取得日時.
+	// 取得日時 (UNIXミリ秒).
 	RetrievedAt int64 `json:"retrieved_at"`
 }
 
@@ -82,35 +98,152 @@ func (s *AppGetNearbyChairsOKChairsItem) SetCurrentCoordinate(val Coordinate) {
 	s.CurrentCoordinate = val
 }
 
-// 自分のライドが１つでも存在する場合は最新のものをdataで返す。過去にライドが１つも存在しない場合、dataはnullまたはundefined.
-type AppGetNotificationOK struct {
+// 自分のライドが１つでも存在する場合は最新のものをdataで返す。過去にライドが１つも存在しない場合、dataは`null`または`undefined`。キャンセルされたライドはCANCELEDで通知される.
+type AppGetNotificationOKApplicationJSON struct {
 	Data OptUserNotificationData `json:"data"`
 	// 次回の通知ポーリングまでの待機時間(ミリ秒単位).
 	RetryAfterMs OptInt `json:"retry_after_ms"`
 }
 
 // GetData returns the value of Data.
-func (s *AppGetNotificationOK) GetData() OptUserNotificationData {
+func (s *AppGetNotificationOKApplicationJSON) GetData() OptUserNotificationData {
 	return s.Data
 }
 
 // GetRetryAfterMs returns the value of RetryAfterMs.
-func (s *AppGetNotificationOK) GetRetryAfterMs() OptInt {
+func (s *AppGetNotificationOKApplicationJSON) GetRetryAfterMs() OptInt {
 	return s.RetryAfterMs
 }
 
 // SetData sets the value of Data.
-func (s *AppGetNotificationOK) SetData(val OptUserNotificationData) {
+func (s *AppGetNotificationOKApplicationJSON) SetData(val OptUserNotificationData) {
 	s.Data = val
 }
 
 // SetRetryAfterMs sets the value of RetryAfterMs.
-func (s *AppGetNotificationOK) SetRetryAfterMs(val OptInt) {
+func (s *AppGetNotificationOKApplicationJSON) SetRetryAfterMs(val OptInt) {
 	s.RetryAfterMs = val
 }
 
+type AppGetPaymentMethodsOK struct {
+	PaymentMethods []AppGetPaymentMethodsOKPaymentMethodsItem `json:"payment_methods"`
+}
+
+// GetPaymentMethods returns the value of PaymentMethods.
+func (s *AppGetPaymentMethodsOK) GetPaymentMethods() []AppGetPaymentMethodsOKPaymentMethodsItem {
+	return s.PaymentMethods
+}
+
+// SetPaymentMethods sets the value of PaymentMethods.
+func (s *AppGetPaymentMethodsOK) SetPaymentMethods(val []AppGetPaymentMethodsOKPaymentMethodsItem) {
+	s.PaymentMethods = val
+}
+
+type AppGetPaymentMethodsOKPaymentMethodsItem struct {
+	// 決済トークンのID.
+	ID string `json:"id"`
+	// 決済トークンの末尾4文字.
+	Last4 string `json:"last4"`
+	// デフォルトの決済トークンかどうか.
+	IsDefault bool `json:"is_default"`
+	// 登録日時.
+	CreatedAt int64 `json:"created_at"`
+}
+
+// GetID returns the value of ID.
+func (s *AppGetPaymentMethodsOKPaymentMethodsItem) GetID() string {
+	return s.ID
+}
+
+// GetLast4 returns the value of Last4.
+func (s *AppGetPaymentMethodsOKPaymentMethodsItem) GetLast4() string {
+	return s.Last4
+}
+
+// GetIsDefault returns the value of IsDefault.
+func (s *AppGetPaymentMethodsOKPaymentMethodsItem) GetIsDefault() bool {
+	return s.IsDefault
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *AppGetPaymentMethodsOKPaymentMethodsItem) GetCreatedAt() int64 {
+	return s.CreatedAt
+}
+
+// SetID sets the value of ID.
+func (s *AppGetPaymentMethodsOKPaymentMethodsItem) SetID(val string) {
+	s.ID = val
+}
+
+// SetLast4 sets the value of Last4.
+func (s *AppGetPaymentMethodsOKPaymentMethodsItem) SetLast4(val string) {
+	s.Last4 = val
+}
+
+// SetIsDefault sets the value of IsDefault.
+func (s *AppGetPaymentMethodsOKPaymentMethodsItem) SetIsDefault(val bool) {
+	s.IsDefault = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *AppGetPaymentMethodsOKPaymentMethodsItem) SetCreatedAt(val int64) {
+	s.CreatedAt = val
+}
+
+type AppGetRidePaymentOK struct {
+	// ライドID.
+	RideID     string         `json:"ride_id"`
+	RideStatus RideStatus     `json:"ride_status"`
+	Payment    OptRidePayment `json:"payment"`
+	Tip        OptRidePayment `json:"tip"`
+}
+
+// GetRideID returns the value of RideID.
+func (s *AppGetRidePaymentOK) GetRideID() string {
+	return s.RideID
+}
+
+// GetRideStatus returns the value of RideStatus.
+func (s *AppGetRidePaymentOK) GetRideStatus() RideStatus {
+	return s.RideStatus
+}
+
+// GetPayment returns the value of Payment.
+func (s *AppGetRidePaymentOK) GetPayment() OptRidePayment {
+	return s.Payment
+}
+
+// GetTip returns the value of Tip.
+func (s *AppGetRidePaymentOK) GetTip() OptRidePayment {
+	return s.Tip
+}
+
+// SetRideID sets the value of RideID.
+func (s *AppGetRidePaymentOK) SetRideID(val string) {
+	s.RideID = val
+}
+
+// SetRideStatus sets the value of RideStatus.
+func (s *AppGetRidePaymentOK) SetRideStatus(val RideStatus) {
+	s.RideStatus = val
+}
+
+// SetPayment sets the value of Payment.
+func (s *AppGetRidePaymentOK) SetPayment(val OptRidePayment) {
+	s.Payment = val
+}
+
+// SetTip sets the value of Tip.
+func (s *AppGetRidePaymentOK) SetTip(val OptRidePayment) {
+	s.Tip = val
+}
+
+func (*AppGetRidePaymentOK) appGetRidePaymentRes() {}
+
 type AppGetRidesOK struct {
 	Rides []AppGetRidesOKRidesItem `json:"rides"`
+	// マッチングを始めるのを待っている予約済みのライド。配車日時が近い順に並ぶ。無ければ含まれない.
+	ScheduledRides []AppGetRidesOKScheduledRidesItem `json:"scheduled_rides"`
 }
 
 // GetRides returns the value of Rides.
@@ -118,24 +251,39 @@ func (s *AppGetRidesOK) GetRides() []AppGetRidesOKRidesItem {
 	return s.Rides
 }
 
+// GetScheduledRides returns the value of ScheduledRides.
+func (s *AppGetRidesOK) GetScheduledRides() []AppGetRidesOKScheduledRidesItem {
+	return s.ScheduledRides
+}
+
 // SetRides sets the value of Rides.
 func (s *AppGetRidesOK) SetRides(val []AppGetRidesOKRidesItem) {
 	s.Rides = val
 }
 
+// SetScheduledRides sets the value of ScheduledRides.
+func (s *AppGetRidesOK) SetScheduledRides(val []AppGetRidesOKScheduledRidesItem) {
+	s.ScheduledRides = val
+}
+
+// Pickup_coordinateは配車位置、destination_coordinateは目的地.
 type AppGetRidesOKRidesItem struct {
 	// ライドID.
 	ID                    string     `json:"id"`
 	PickupCoordinate      Coordinate `json:"pickup_coordinate"`
 	DestinationCoordinate Coordinate `json:"destination_coordinate"`
-	// 運賃.
-	Fare  int                         `json:"fare"`
+	// 運賃(割引後).
+	Fare int `json:"fare"`
+	// チップ。払っていなければ0.
+	Tip   OptInt                      `json:"tip"`
 	Chair AppGetRidesOKRidesItemChair `json:"chair"`
 	// 椅子の評価.
 	Evaluation int `json:"evaluation"`
-	// 配車要求日時.
+	// 予約した配車日時 (UNIXミリ秒)。予約でなければ含まれない.
+	ScheduledAt OptInt64 `json:"scheduled_at"`
+	// 配車要求日時 (UNIXミリ秒).
 	RequestedAt int64 `json:"requested_at"`
-	// 評価まで完了した日時.
+	// 評価まで完了した日時 (UNIXミリ秒).
 	CompletedAt int64 `json:"completed_at"`
 }
 
@@ -159,6 +307,11 @@ func (s *AppGetRidesOKRidesItem) GetFare() int {
 	return s.Fare
 }
 
+// GetTip returns the value of Tip.
+func (s *AppGetRidesOKRidesItem) GetTip() OptInt {
+	return s.Tip
+}
+
 // GetChair returns the value of Chair.
 func (s *AppGetRidesOKRidesItem) GetChair() AppGetRidesOKRidesItemChair {
 	return s.Chair
@@ -169,6 +322,11 @@ func (s *AppGetRidesOKRidesItem) GetEvaluation() int {
 	return s.Evaluation
 }
 
+// GetScheduledAt returns the value of ScheduledAt.
+func (s *AppGetRidesOKRidesItem) GetScheduledAt() OptInt64 {
+	return s.ScheduledAt
+}
+
 // GetRequestedAt returns the value of RequestedAt.
 func (s *AppGetRidesOKRidesItem) GetRequestedAt() int64 {
 	return s.RequestedAt
@@ -199,6 +357,11 @@ func (s *AppGetRidesOKRidesItem) SetFare(val int) {
 	s.Fare = val
 }
 
+// SetTip sets the value of Tip.
+func (s *AppGetRidesOKRidesItem) SetTip(val OptInt) {
+	s.Tip = val
+}
+
 // SetChair sets the value of Chair.
 func (s *AppGetRidesOKRidesItem) SetChair(val AppGetRidesOKRidesItemChair) {
 	s.Chair = val
@@ -209,6 +372,11 @@ func (s *AppGetRidesOKRidesItem) SetEvaluation(val int) {
 	s.Evaluation = val
 }
 
+// SetScheduledAt sets the value of ScheduledAt.
+func (s *AppGetRidesOKRidesItem) SetScheduledAt(val OptInt64) {
+	s.ScheduledAt = val
+}
+
 // SetRequestedAt sets the value of RequestedAt.
 func (s *AppGetRidesOKRidesItem) SetRequestedAt(val int64) {
 	s.RequestedAt = val
@@ -270,6 +438,238 @@ func (s *AppGetRidesOKRidesItemChair) SetModel(val string) {
 	s.Model = val
 }
 
+type AppGetRidesOKScheduledRidesItem struct {
+	// ライドID.
+	ID                    string     `json:"id"`
+	PickupCoordinate      Coordinate `json:"pickup_coordinate"`
+	DestinationCoordinate Coordinate `json:"destination_coordinate"`
+	// 運賃(割引後).
+	Fare int `json:"fare"`
+	// 予約した配車日時 (UNIXミリ秒).
+	ScheduledAt int64 `json:"scheduled_at"`
+	// 配車要求日時 (UNIXミリ秒).
+	RequestedAt int64 `json:"requested_at"`
+}
+
+// GetID returns the value of ID.
+func (s *AppGetRidesOKScheduledRidesItem) GetID() string {
+	return s.ID
+}
+
+// GetPickupCoordinate returns the value of PickupCoordinate.
+func (s *AppGetRidesOKScheduledRidesItem) GetPickupCoordinate() Coordinate {
+	return s.PickupCoordinate
+}
+
+// GetDestinationCoordinate returns the value of DestinationCoordinate.
+func (s *AppGetRidesOKScheduledRidesItem) GetDestinationCoordinate() Coordinate {
+	return s.DestinationCoordinate
+}
+
+// GetFare returns the value of Fare.
+func (s *AppGetRidesOKScheduledRidesItem) GetFare() int {
+	return s.Fare
+}
+
+// GetScheduledAt returns the value of ScheduledAt.
+func (s *AppGetRidesOKScheduledRidesItem) GetScheduledAt() int64 {
+	return s.ScheduledAt
+}
+
+// GetRequestedAt returns the value of RequestedAt.
+func (s *AppGetRidesOKScheduledRidesItem) GetRequestedAt() int64 {
+	return s.RequestedAt
+}
+
+// SetID sets the value of ID.
+func (s *AppGetRidesOKScheduledRidesItem) SetID(val string) {
+	s.ID = val
+}
+
+// SetPickupCoordinate sets the value of PickupCoordinate.
+func (s *AppGetRidesOKScheduledRidesItem) SetPickupCoordinate(val Coordinate) {
+	s.PickupCoordinate = val
+}
+
+// SetDestinationCoordinate sets the value of DestinationCoordinate.
+func (s *AppGetRidesOKScheduledRidesItem) SetDestinationCoordinate(val Coordinate) {
+	s.DestinationCoordinate = val
+}
+
+// SetFare sets the value of Fare.
+func (s *AppGetRidesOKScheduledRidesItem) SetFare(val int) {
+	s.Fare = val
+}
+
+// SetScheduledAt sets the value of ScheduledAt.
+func (s *AppGetRidesOKScheduledRidesItem) SetScheduledAt(val int64) {
+	s.ScheduledAt = val
+}
+
+// SetRequestedAt sets the value of RequestedAt.
+func (s *AppGetRidesOKScheduledRidesItem) SetRequestedAt(val int64) {
+	s.RequestedAt = val
+}
+
+type AppPatchRideBadRequest Error
+
+func (*AppPatchRideBadRequest) appPatchRideRes() {}
+
+type AppPatchRideNotFound Error
+
+func (*AppPatchRideNotFound) appPatchRideRes() {}
+
+type AppPatchRideOK struct {
+	// ライドID.
+	RideID string `json:"ride_id"`
+	// 運賃(割引後).
+	Fare int `json:"fare"`
+	// 予約した配車日時 (UNIXミリ秒).
+	ScheduledAt OptInt64 `json:"scheduled_at"`
+}
+
+// GetRideID returns the value of RideID.
+func (s *AppPatchRideOK) GetRideID() string {
+	return s.RideID
+}
+
+// GetFare returns the value of Fare.
+func (s *AppPatchRideOK) GetFare() int {
+	return s.Fare
+}
+
+// GetScheduledAt returns the value of ScheduledAt.
+func (s *AppPatchRideOK) GetScheduledAt() OptInt64 {
+	return s.ScheduledAt
+}
+
+// SetRideID sets the value of RideID.
+func (s *AppPatchRideOK) SetRideID(val string) {
+	s.RideID = val
+}
+
+// SetFare sets the value of Fare.
+func (s *AppPatchRideOK) SetFare(val int) {
+	s.Fare = val
+}
+
+// SetScheduledAt sets the value of ScheduledAt.
+func (s *AppPatchRideOK) SetScheduledAt(val OptInt64) {
+	s.ScheduledAt = val
+}
+
+func (*AppPatchRideOK) appPatchRideRes() {}
+
+type AppPatchRideReq struct {
+	PickupCoordinate      OptCoordinate `json:"pickup_coordinate"`
+	DestinationCoordinate OptCoordinate `json:"destination_coordinate"`
+	// 予約する配車日時 (UNIXミリ秒)。未来の日時でなければならない.
+	ScheduledAt OptInt64 `json:"scheduled_at"`
+}
+
+// GetPickupCoordinate returns the value of PickupCoordinate.
+func (s *AppPatchRideReq) GetPickupCoordinate() OptCoordinate {
+	return s.PickupCoordinate
+}
+
+// GetDestinationCoordinate returns the value of DestinationCoordinate.
+func (s *AppPatchRideReq) GetDestinationCoordinate() OptCoordinate {
+	return s.DestinationCoordinate
+}
+
+// GetScheduledAt returns the value of ScheduledAt.
+func (s *AppPatchRideReq) GetScheduledAt() OptInt64 {
+	return s.ScheduledAt
+}
+
+// SetPickupCoordinate sets the value of PickupCoordinate.
+func (s *AppPatchRideReq) SetPickupCoordinate(val OptCoordinate) {
+	s.PickupCoordinate = val
+}
+
+// SetDestinationCoordinate sets the value of DestinationCoordinate.
+func (s *AppPatchRideReq) SetDestinationCoordinate(val OptCoordinate) {
+	s.DestinationCoordinate = val
+}
+
+// SetScheduledAt sets the value of ScheduledAt.
+func (s *AppPatchRideReq) SetScheduledAt(val OptInt64) {
+	s.ScheduledAt = val
+}
+
+type AppPostCouponsBadRequest Error
+
+func (*AppPostCouponsBadRequest) appPostCouponsRes() {}
+
+type AppPostCouponsConflict Error
+
+func (*AppPostCouponsConflict) appPostCouponsRes() {}
+
+type AppPostCouponsCreated struct {
+	// クーポンコード.
+	Code string `json:"code"`
+	// 割引額.
+	DiscountAmount OptInt `json:"discount_amount"`
+	// 距離に応じた運賃に対する割引率(%).
+	DiscountPercentage OptInt `json:"discount_percentage"`
+}
+
+// GetCode returns the value of Code.
+func (s *AppPostCouponsCreated) GetCode() string {
+	return s.Code
+}
+
+// GetDiscountAmount returns the value of DiscountAmount.
+func (s *AppPostCouponsCreated) GetDiscountAmount() OptInt {
+	return s.DiscountAmount
+}
+
+// GetDiscountPercentage returns the value of DiscountPercentage.
+func (s *AppPostCouponsCreated) GetDiscountPercentage() OptInt {
+	return s.DiscountPercentage
+}
+
+// SetCode sets the value of Code.
+func (s *AppPostCouponsCreated) SetCode(val string) {
+	s.Code = val
+}
+
+// SetDiscountAmount sets the value of DiscountAmount.
+func (s *AppPostCouponsCreated) SetDiscountAmount(val OptInt) {
+	s.DiscountAmount = val
+}
+
+// SetDiscountPercentage sets the value of DiscountPercentage.
+func (s *AppPostCouponsCreated) SetDiscountPercentage(val OptInt) {
+	s.DiscountPercentage = val
+}
+
+func (*AppPostCouponsCreated) appPostCouponsRes() {}
+
+type AppPostCouponsNotFound Error
+
+func (*AppPostCouponsNotFound) appPostCouponsRes() {}
+
+type AppPostCouponsReq struct {
+	// クーポンコード.
+	Code string `json:"code"`
+}
+
+// GetCode returns the value of Code.
+func (s *AppPostCouponsReq) GetCode() string {
+	return s.Code
+}
+
+// SetCode sets the value of Code.
+func (s *AppPostCouponsReq) SetCode(val string) {
+	s.Code = val
+}
+
+// AppPostPaymentMethodDefaultNoContent is response for AppPostPaymentMethodDefault operation.
+type AppPostPaymentMethodDefaultNoContent struct{}
+
+func (*AppPostPaymentMethodDefaultNoContent) appPostPaymentMethodDefaultRes() {}
+
 // AppPostPaymentMethodsNoContent is response for AppPostPaymentMethods operation.
 type AppPostPaymentMethodsNoContent struct{}
 
@@ -278,6 +678,8 @@ func (*AppPostPaymentMethodsNoContent) appPostPaymentMethodsRes() {}
 type AppPostPaymentMethodsReq struct {
 	// 決済トークン.
 	Token string `json:"token"`
+	// デフォルトの決済トークンにするかどうか。最初に登録した決済トークンは指定が無くてもデフォルトになる.
+	IsDefault OptBool `json:"is_default"`
 }
 
 // GetToken returns the value of Token.
@@ -285,11 +687,58 @@ func (s *AppPostPaymentMethodsReq) GetToken() string {
 	return s.Token
 }
 
+// GetIsDefault returns the value of IsDefault.
+func (s *AppPostPaymentMethodsReq) GetIsDefault() OptBool {
+	return s.IsDefault
+}
+
 // SetToken sets the value of Token.
 func (s *AppPostPaymentMethodsReq) SetToken(val string) {
 	s.Token = val
 }
 
+// SetIsDefault sets the value of IsDefault.
+func (s *AppPostPaymentMethodsReq) SetIsDefault(val OptBool) {
+	s.IsDefault = val
+}
+
+type AppPostRideCancelBadRequest Error
+
+func (*AppPostRideCancelBadRequest) appPostRideCancelRes() {}
+
+type AppPostRideCancelNotFound Error
+
+func (*AppPostRideCancelNotFound) appPostRideCancelRes() {}
+
+type AppPostRideCancelOK struct {
+	// キャンセル料.
+	CancellationFee int `json:"cancellation_fee"`
+	// キャンセル日時 (UNIXミリ秒).
+	CanceledAt int64 `json:"canceled_at"`
+}
+
+// GetCancellationFee returns the value of CancellationFee.
+func (s *AppPostRideCancelOK) GetCancellationFee() int {
+	return s.CancellationFee
+}
+
+// GetCanceledAt returns the value of CanceledAt.
+func (s *AppPostRideCancelOK) GetCanceledAt() int64 {
+	return s.CanceledAt
+}
+
+// SetCancellationFee sets the value of CancellationFee.
+func (s *AppPostRideCancelOK) SetCancellationFee(val int) {
+	s.CancellationFee = val
+}
+
+// SetCanceledAt sets the value of CanceledAt.
+func (s *AppPostRideCancelOK) SetCanceledAt(val int64) {
+	s.CanceledAt = val
+}
+
+func (*AppPostRideCancelOK) appPostRideCancelRes() {}
+
 type AppPostRideEvaluationBadRequest Error
 
 func (*AppPostRideEvaluationBadRequest) appPostRideEvaluationRes() {}
@@ -299,7 +748,7 @@ type AppPostRideEvaluationNotFound Error
 func (*AppPostRideEvaluationNotFound) appPostRideEvaluationRes() {}
 
 type AppPostRideEvaluationOK struct {
-	// 完了日時.
+	// 完了日時 (UNIXミリ秒).
 	CompletedAt int64 `json:"completed_at"`
 }
 
@@ -318,6 +767,8 @@ func (*AppPostRideEvaluationOK) appPostRideEvaluationRes() {}
 type AppPostRideEvaluationReq struct {
 	// ライドの評価.
 	Evaluation int `json:"evaluation"`
+	// 椅子に払うチップ。運賃とは別に決済し、クーポンは使わない.
+	Tip OptInt `json:"tip"`
 }
 
 // GetEvaluation returns the value of Evaluation.
@@ -325,16 +776,29 @@ func (s *AppPostRideEvaluationReq) GetEvaluation() int {
 	return s.Evaluation
 }
 
+// GetTip returns the value of Tip.
+func (s *AppPostRideEvaluationReq) GetTip() OptInt {
+	return s.Tip
+}
+
 // SetEvaluation sets the value of Evaluation.
 func (s *AppPostRideEvaluationReq) SetEvaluation(val int) {
 	s.Evaluation = val
 }
 
+// SetTip sets the value of Tip.
+func (s *AppPostRideEvaluationReq) SetTip(val OptInt) {
+	s.Tip = val
+}
+
 type AppPostRidesAccepted struct {
 	// ライドID.
 	RideID string `json:"ride_id"`
-	// 割引後運賃.
-	Fare int `json:"fare"`
+	// 運賃(割引後).
+	Fare      int       `json:"fare"`
+	SurgeRate SurgeRate `json:"surge_rate"`
+	// 予約した配車日時 (UNIXミリ秒)。予約でなければ含まれない.
+	ScheduledAt OptInt64 `json:"scheduled_at"`
 }
 
 // GetRideID returns the value of RideID.
@@ -347,6 +811,16 @@ func (s *AppPostRidesAccepted) GetFare() int {
 	return s.Fare
 }
 
+// GetSurgeRate returns the value of SurgeRate.
+func (s *AppPostRidesAccepted) GetSurgeRate() SurgeRate {
+	return s.SurgeRate
+}
+
+// GetScheduledAt returns the value of ScheduledAt.
+func (s *AppPostRidesAccepted) GetScheduledAt() OptInt64 {
+	return s.ScheduledAt
+}
+
 // SetRideID sets the value of RideID.
 func (s *AppPostRidesAccepted) SetRideID(val string) {
 	s.RideID = val
@@ -357,6 +831,16 @@ func (s *AppPostRidesAccepted) SetFare(val int) {
 	s.Fare = val
 }
 
+// SetSurgeRate sets the value of SurgeRate.
+func (s *AppPostRidesAccepted) SetSurgeRate(val SurgeRate) {
+	s.SurgeRate = val
+}
+
+// SetScheduledAt sets the value of ScheduledAt.
+func (s *AppPostRidesAccepted) SetScheduledAt(val OptInt64) {
+	s.ScheduledAt = val
+}
+
 func (*AppPostRidesAccepted) appPostRidesRes() {}
 
 type AppPostRidesBadRequest Error
@@ -368,10 +852,11 @@ type AppPostRidesConflict Error
 func (*AppPostRidesConflict) appPostRidesRes() {}
 
 type AppPostRidesEstimatedFareOK struct {
-	// 割引後運賃.
+	// 割引後の運賃.
 	Fare int `json:"fare"`
 	// 割引額.
-	Discount int `json:"discount"`
+	Discount  int       `json:"discount"`
+	SurgeRate SurgeRate `json:"surge_rate"`
 }
 
 // GetFare returns the value of Fare.
@@ -384,6 +869,11 @@ func (s *AppPostRidesEstimatedFareOK) GetDiscount() int {
 	return s.Discount
 }
 
+// GetSurgeRate returns the value of SurgeRate.
+func (s *AppPostRidesEstimatedFareOK) GetSurgeRate() SurgeRate {
+	return s.SurgeRate
+}
+
 // SetFare sets the value of Fare.
 func (s *AppPostRidesEstimatedFareOK) SetFare(val int) {
 	s.Fare = val
@@ -394,13 +884,21 @@ func (s *AppPostRidesEstimatedFareOK) SetDiscount(val int) {
 	s.Discount = val
 }
 
+// SetSurgeRate sets the value of SurgeRate.
+func (s *AppPostRidesEstimatedFareOK) SetSurgeRate(val SurgeRate) {
+	s.SurgeRate = val
+}
+
 func (*AppPostRidesEstimatedFareOK) appPostRidesEstimatedFareRes() {}
 
+// Pickup_coordinateは配車位置、destination_coordinateは目的地.
 type AppPostRidesEstimatedFareReq struct {
-	// 配車位置.
-	PickupCoordinate Coordinate `json:"pickup_coordinate"`
-	// 目的地.
+	PickupCoordinate      Coordinate `json:"pickup_coordinate"`
 	DestinationCoordinate Coordinate `json:"destination_coordinate"`
+	// 配車位置から目的地までに順に経由する位置。最大3件。直前の位置(配車位置もしくは1つ前の経由地)と同じ位置や、最後の経由地に目的地と同じ位置は指定できない.
+	Stops []Coordinate `json:"stops"`
+	// 相乗りを希望するかどうか。他のライドと同じ椅子に乗り合わせると、距離に応じた運賃が20%割引される。経由地とは同時に指定できない.
+	Pooled OptBool `json:"pooled"`
 }
 
 // GetPickupCoordinate returns the value of PickupCoordinate.
@@ -413,8 +911,18 @@ func (s *AppPostRidesEstimatedFareReq) GetDestinationCoordinate() Coordinate {
 	return s.DestinationCoordinate
 }
 
-// SetPickupCoordinate sets the value of PickupCoordinate.
-func (s *AppPostRidesEstimatedFareReq) SetPickupCoordinate(val Coordinate) {
+// GetStops returns the value of Stops.
+func (s *AppPostRidesEstimatedFareReq) GetStops() []Coordinate {
+	return s.Stops
+}
+
+// GetPooled returns the value of Pooled.
+func (s *AppPostRidesEstimatedFareReq) GetPooled() OptBool {
+	return s.Pooled
+}
+
+// SetPickupCoordinate sets the value of PickupCoordinate.
+func (s *AppPostRidesEstimatedFareReq) SetPickupCoordinate(val Coordinate) {
 	s.PickupCoordinate = val
 }
 
@@ -423,11 +931,28 @@ func (s *AppPostRidesEstimatedFareReq) SetDestinationCoordinate(val Coordinate)
 	s.DestinationCoordinate = val
 }
 
+// SetStops sets the value of Stops.
+func (s *AppPostRidesEstimatedFareReq) SetStops(val []Coordinate) {
+	s.Stops = val
+}
+
+// SetPooled sets the value of Pooled.
+func (s *AppPostRidesEstimatedFareReq) SetPooled(val OptBool) {
+	s.Pooled = val
+}
+
+// Pickup_coordinateは配車位置、destination_coordinateは目的地.
 type AppPostRidesReq struct {
-	// 配車位置.
-	PickupCoordinate Coordinate `json:"pickup_coordinate"`
-	// 目的地.
+	PickupCoordinate      Coordinate `json:"pickup_coordinate"`
 	DestinationCoordinate Coordinate `json:"destination_coordinate"`
+	// 配車位置から目的地までに順に経由する位置。最大3件。直前の位置(配車位置もしくは1つ前の経由地)と同じ位置や、最後の経由地に目的地と同じ位置は指定できない.
+	Stops []Coordinate `json:"stops"`
+	// 相乗りを希望するかどうか。他のライドと同じ椅子に乗り合わせると、距離に応じた運賃が20%割引される。経由地とは同時に指定できない.
+	Pooled OptBool `json:"pooled"`
+	// 予約する配車日時 (UNIXミリ秒)。未来の日時でなければならない.
+	ScheduledAt OptInt64 `json:"scheduled_at"`
+	// 支払いに使う決済トークンのID。指定しなければデフォルトの決済トークンを使う.
+	PaymentMethodID OptString `json:"payment_method_id"`
 }
 
 // GetPickupCoordinate returns the value of PickupCoordinate.
@@ -440,6 +965,26 @@ func (s *AppPostRidesReq) GetDestinationCoordinate() Coordinate {
 	return s.DestinationCoordinate
 }
 
+// GetStops returns the value of Stops.
+func (s *AppPostRidesReq) GetStops() []Coordinate {
+	return s.Stops
+}
+
+// GetPooled returns the value of Pooled.
+func (s *AppPostRidesReq) GetPooled() OptBool {
+	return s.Pooled
+}
+
+// GetScheduledAt returns the value of ScheduledAt.
+func (s *AppPostRidesReq) GetScheduledAt() OptInt64 {
+	return s.ScheduledAt
+}
+
+// GetPaymentMethodID returns the value of PaymentMethodID.
+func (s *AppPostRidesReq) GetPaymentMethodID() OptString {
+	return s.PaymentMethodID
+}
+
 // SetPickupCoordinate sets the value of PickupCoordinate.
 func (s *AppPostRidesReq) SetPickupCoordinate(val Coordinate) {
 	s.PickupCoordinate = val
@@ -450,6 +995,26 @@ func (s *AppPostRidesReq) SetDestinationCoordinate(val Coordinate) {
 	s.DestinationCoordinate = val
 }
 
+// SetStops sets the value of Stops.
+func (s *AppPostRidesReq) SetStops(val []Coordinate) {
+	s.Stops = val
+}
+
+// SetPooled sets the value of Pooled.
+func (s *AppPostRidesReq) SetPooled(val OptBool) {
+	s.Pooled = val
+}
+
+// SetScheduledAt sets the value of ScheduledAt.
+func (s *AppPostRidesReq) SetScheduledAt(val OptInt64) {
+	s.ScheduledAt = val
+}
+
+// SetPaymentMethodID sets the value of PaymentMethodID.
+func (s *AppPostRidesReq) SetPaymentMethodID(val OptString) {
+	s.PaymentMethodID = val
+}
+
 type AppPostUsersCreated struct {
 	// ユーザーID.
 	ID string `json:"id"`
@@ -506,14 +1071,14 @@ func (s *AppPostUsersCreatedHeaders) SetResponse(val AppPostUsersCreated) {
 func (*AppPostUsersCreatedHeaders) appPostUsersRes() {}
 
 type AppPostUsersReq struct {
-	// ユーザー名.
+	// ユーザー名 (ユニーク).
 	Username string `json:"username"`
 	// 名前.
 	Firstname string `json:"firstname"`
 	// 名字.
 	Lastname string `json:"lastname"`
 	// 生年月日.
-	DateOfBirth string `json:"date_of_birth"`
+	DateOfBirth time.Time `json:"date_of_birth"`
 	// 他の人の招待コード.
 	InvitationCode OptString `json:"invitation_code"`
 }
@@ -534,7 +1099,7 @@ func (s *AppPostUsersReq) GetLastname() string {
 }
 
 // GetDateOfBirth returns the value of DateOfBirth.
-func (s *AppPostUsersReq) GetDateOfBirth() string {
+func (s *AppPostUsersReq) GetDateOfBirth() time.Time {
 	return s.DateOfBirth
 }
 
@@ -559,7 +1124,7 @@ func (s *AppPostUsersReq) SetLastname(val string) {
 }
 
 // SetDateOfBirth sets the value of DateOfBirth.
-func (s *AppPostUsersReq) SetDateOfBirth(val string) {
+func (s *AppPostUsersReq) SetDateOfBirth(val time.Time) {
 	s.DateOfBirth = val
 }
 
@@ -568,30 +1133,107 @@ func (s *AppPostUsersReq) SetInvitationCode(val OptString) {
 	s.InvitationCode = val
 }
 
-// 自分に割り当てられたライドが１つでも存在する場合は最新のものをdataで返す。過去にライドが１つも割り当てられていない場合、dataはnullまたはundefined.
-type ChairGetNotificationOK struct {
+type ChairGetEarningsOK struct {
+	// 売上の合計。返金を差し引く.
+	TotalSales int `json:"total_sales"`
+	// 完了したライドの売上.
+	RideSales int `json:"ride_sales"`
+	// キャンセル料の売上.
+	CancellationFees int `json:"cancellation_fees"`
+	// 返金が済んだ額の合計.
+	Refunds int `json:"refunds"`
+	// 完了したライドのチップ。売上の合計には含めない.
+	Tips int `json:"tips"`
+	// 完了したライドの数.
+	CompletedRides int `json:"completed_rides"`
+}
+
+// GetTotalSales returns the value of TotalSales.
+func (s *ChairGetEarningsOK) GetTotalSales() int {
+	return s.TotalSales
+}
+
+// GetRideSales returns the value of RideSales.
+func (s *ChairGetEarningsOK) GetRideSales() int {
+	return s.RideSales
+}
+
+// GetCancellationFees returns the value of CancellationFees.
+func (s *ChairGetEarningsOK) GetCancellationFees() int {
+	return s.CancellationFees
+}
+
+// GetRefunds returns the value of Refunds.
+func (s *ChairGetEarningsOK) GetRefunds() int {
+	return s.Refunds
+}
+
+// GetTips returns the value of Tips.
+func (s *ChairGetEarningsOK) GetTips() int {
+	return s.Tips
+}
+
+// GetCompletedRides returns the value of CompletedRides.
+func (s *ChairGetEarningsOK) GetCompletedRides() int {
+	return s.CompletedRides
+}
+
+// SetTotalSales sets the value of TotalSales.
+func (s *ChairGetEarningsOK) SetTotalSales(val int) {
+	s.TotalSales = val
+}
+
+// SetRideSales sets the value of RideSales.
+func (s *ChairGetEarningsOK) SetRideSales(val int) {
+	s.RideSales = val
+}
+
+// SetCancellationFees sets the value of CancellationFees.
+func (s *ChairGetEarningsOK) SetCancellationFees(val int) {
+	s.CancellationFees = val
+}
+
+// SetRefunds sets the value of Refunds.
+func (s *ChairGetEarningsOK) SetRefunds(val int) {
+	s.Refunds = val
+}
+
+// SetTips sets the value of Tips.
+func (s *ChairGetEarningsOK) SetTips(val int) {
+	s.Tips = val
+}
+
+// SetCompletedRides sets the value of CompletedRides.
+func (s *ChairGetEarningsOK) SetCompletedRides(val int) {
+	s.CompletedRides = val
+}
+
+func (*ChairGetEarningsOK) chairGetEarningsRes() {}
+
+// 自分に割り当てられたライドが１つでも存在する場合は最新のものをdataで返す。過去にライドが１つも割り当てられていない場合、dataは`null`または`undefined`.
+type ChairGetNotificationOKApplicationJSON struct {
 	Data OptChairNotificationData `json:"data"`
-	// 次回の通知ポーリングまでの待機時間(ミリ秒単位).
+	// 次回の通知ポーリングまでの待機時間 (ミリ秒単位).
 	RetryAfterMs OptInt `json:"retry_after_ms"`
 }
 
 // GetData returns the value of Data.
-func (s *ChairGetNotificationOK) GetData() OptChairNotificationData {
+func (s *ChairGetNotificationOKApplicationJSON) GetData() OptChairNotificationData {
 	return s.Data
 }
 
 // GetRetryAfterMs returns the value of RetryAfterMs.
-func (s *ChairGetNotificationOK) GetRetryAfterMs() OptInt {
+func (s *ChairGetNotificationOKApplicationJSON) GetRetryAfterMs() OptInt {
 	return s.RetryAfterMs
 }
 
 // SetData sets the value of Data.
-func (s *ChairGetNotificationOK) SetData(val OptChairNotificationData) {
+func (s *ChairGetNotificationOKApplicationJSON) SetData(val OptChairNotificationData) {
 	s.Data = val
 }
 
 // SetRetryAfterMs sets the value of RetryAfterMs.
-func (s *ChairGetNotificationOK) SetRetryAfterMs(val OptInt) {
+func (s *ChairGetNotificationOKApplicationJSON) SetRetryAfterMs(val OptInt) {
 	s.RetryAfterMs = val
 }
 
@@ -603,7 +1245,15 @@ type ChairNotificationData struct {
 	User                  User       `json:"user"`
 	PickupCoordinate      Coordinate `json:"pickup_coordinate"`
 	DestinationCoordinate Coordinate `json:"destination_coordinate"`
-	Status                RideStatus `json:"status"`
+	// 経由地。経由地が無ければ含まれない.
+	Stops []RideStop `json:"stops"`
+	// 椅子が次に向かう位置。乗車までは配車位置、乗車後はまだ到着していない次の経由地、全ての経由地に到着した後は目的地.
+	NextCoordinate Coordinate `json:"next_coordinate"`
+	// 相乗りを希望したライドかどうか。相乗りでは複数のライドの通知が届く.
+	IsPooled bool       `json:"is_pooled"`
+	Status   RideStatus `json:"status"`
+	// 予約した配車日時 (UNIXミリ秒)。予約でなければ含まれない.
+	ScheduledAt OptInt64 `json:"scheduled_at"`
 }
 
 // GetRideID returns the value of RideID.
@@ -626,11 +1276,31 @@ func (s *ChairNotificationData) GetDestinationCoordinate() Coordinate {
 	return s.DestinationCoordinate
 }
 
+// GetStops returns the value of Stops.
+func (s *ChairNotificationData) GetStops() []RideStop {
+	return s.Stops
+}
+
+// GetNextCoordinate returns the value of NextCoordinate.
+func (s *ChairNotificationData) GetNextCoordinate() Coordinate {
+	return s.NextCoordinate
+}
+
+// GetIsPooled returns the value of IsPooled.
+func (s *ChairNotificationData) GetIsPooled() bool {
+	return s.IsPooled
+}
+
 // GetStatus returns the value of Status.
 func (s *ChairNotificationData) GetStatus() RideStatus {
 	return s.Status
 }
 
+// GetScheduledAt returns the value of ScheduledAt.
+func (s *ChairNotificationData) GetScheduledAt() OptInt64 {
+	return s.ScheduledAt
+}
+
 // SetRideID sets the value of RideID.
 func (s *ChairNotificationData) SetRideID(val string) {
 	s.RideID = val
@@ -651,14 +1321,36 @@ func (s *ChairNotificationData) SetDestinationCoordinate(val Coordinate) {
 	s.DestinationCoordinate = val
 }
 
+// SetStops sets the value of Stops.
+func (s *ChairNotificationData) SetStops(val []RideStop) {
+	s.Stops = val
+}
+
+// SetNextCoordinate sets the value of NextCoordinate.
+func (s *ChairNotificationData) SetNextCoordinate(val Coordinate) {
+	s.NextCoordinate = val
+}
+
+// SetIsPooled sets the value of IsPooled.
+func (s *ChairNotificationData) SetIsPooled(val bool) {
+	s.IsPooled = val
+}
+
 // SetStatus sets the value of Status.
 func (s *ChairNotificationData) SetStatus(val RideStatus) {
 	s.Status = val
 }
 
+// SetScheduledAt sets the value of ScheduledAt.
+func (s *ChairNotificationData) SetScheduledAt(val OptInt64) {
+	s.ScheduledAt = val
+}
+
 // ChairPostActivityNoContent is response for ChairPostActivity operation.
 type ChairPostActivityNoContent struct{}
 
+func (*ChairPostActivityNoContent) chairPostActivityRes() {}
+
 type ChairPostActivityReq struct {
 	// 配車受付を開始するか停止するか.
 	IsActive bool `json:"is_active"`
@@ -767,7 +1459,7 @@ func (s *ChairPostChairsReq) SetChairRegisterToken(val string) {
 }
 
 type ChairPostCoordinateOK struct {
-	// 記録日時.
+	// 記録日時 (UNIXミリ秒).
 	RecordedAt int64 `json:"recorded_at"`
 }
 
@@ -781,15 +1473,60 @@ func (s *ChairPostCoordinateOK) SetRecordedAt(val int64) {
 	s.RecordedAt = val
 }
 
+type ChairPostRideEvaluationBadRequest Error
+
+func (*ChairPostRideEvaluationBadRequest) chairPostRideEvaluationRes() {}
+
+type ChairPostRideEvaluationConflict Error
+
+func (*ChairPostRideEvaluationConflict) chairPostRideEvaluationRes() {}
+
+// ChairPostRideEvaluationNoContent is response for ChairPostRideEvaluation operation.
+type ChairPostRideEvaluationNoContent struct{}
+
+func (*ChairPostRideEvaluationNoContent) chairPostRideEvaluationRes() {}
+
+type ChairPostRideEvaluationNotFound Error
+
+func (*ChairPostRideEvaluationNotFound) chairPostRideEvaluationRes() {}
+
+type ChairPostRideEvaluationReq struct {
+	// ユーザーの評価.
+	Evaluation int `json:"evaluation"`
+}
+
+// GetEvaluation returns the value of Evaluation.
+func (s *ChairPostRideEvaluationReq) GetEvaluation() int {
+	return s.Evaluation
+}
+
+// SetEvaluation sets the value of Evaluation.
+func (s *ChairPostRideEvaluationReq) SetEvaluation(val int) {
+	s.Evaluation = val
+}
+
+type ChairPostRideStatusBadRequest Error
+
+func (*ChairPostRideStatusBadRequest) chairPostRideStatusRes() {}
+
 // ChairPostRideStatusNoContent is response for ChairPostRideStatus operation.
 type ChairPostRideStatusNoContent struct{}
 
 func (*ChairPostRideStatusNoContent) chairPostRideStatusRes() {}
 
+type ChairPostRideStatusNotFound Error
+
+func (*ChairPostRideStatusNotFound) chairPostRideStatusRes() {}
+
 type ChairPostRideStatusReq struct {
 	// ライドの状態
-	// ENROUTE: マッチしたライドを確認し、乗車位置に向かう
-	// CARRYING: ユーザーが乗車し、椅子が目的地に向かう.
+	// - ENROUTE: マッチしたライドを確認し、乗車位置に向かう
+	// - CARRYING: ユーザーが乗車し、椅子が目的地に向かう
+	// - MATCHING:
+	// 乗車位置に向かっているライドを辞退し、ライドをマッチング待ちに戻す
+	// - REJECT:
+	// マッチしたライドを確認せずに拒否し、ライドをマッチング待ちに戻す
+	// 辞退・拒否したライドがその椅子に再び割り当てられることはない。ユーザーにはMATCHINGが再び通知される.
 	Status ChairPostRideStatusReqStatus `json:"status"`
 }
 
@@ -804,13 +1541,20 @@ func (s *ChairPostRideStatusReq) SetStatus(val ChairPostRideStatusReqStatus) {
 }
 
 // ライドの状態
-// ENROUTE: マッチしたライドを確認し、乗車位置に向かう
-// CARRYING: ユーザーが乗車し、椅子が目的地に向かう.
+// - ENROUTE: マッチしたライドを確認し、乗車位置に向かう
+// - CARRYING: ユーザーが乗車し、椅子が目的地に向かう
+// - MATCHING:
+// 乗車位置に向かっているライドを辞退し、ライドをマッチング待ちに戻す
+// - REJECT:
+// マッチしたライドを確認せずに拒否し、ライドをマッチング待ちに戻す
+// 辞退・拒否したライドがその椅子に再び割り当てられることはない。ユーザーにはMATCHINGが再び通知される.
 type ChairPostRideStatusReqStatus string
 
 const (
 	ChairPostRideStatusReqStatusENROUTE  ChairPostRideStatusReqStatus = "ENROUTE"
 	ChairPostRideStatusReqStatusCARRYING ChairPostRideStatusReqStatus = "CARRYING"
+	ChairPostRideStatusReqStatusMATCHING ChairPostRideStatusReqStatus = "MATCHING"
+	ChairPostRideStatusReqStatusREJECT   ChairPostRideStatusReqStatus = "REJECT"
 )
 
 // AllValues returns all ChairPostRideStatusReqStatus values.
@@ -818,6 +1562,8 @@ func (ChairPostRideStatusReqStatus) AllValues() []ChairPostRideStatusReqStatus {
 	return []ChairPostRideStatusReqStatus{
 		ChairPostRideStatusReqStatusENROUTE,
 		ChairPostRideStatusReqStatusCARRYING,
+		ChairPostRideStatusReqStatusMATCHING,
+		ChairPostRideStatusReqStatusREJECT,
 	}
 }
 
@@ -828,6 +1574,10 @@ func (s ChairPostRideStatusReqStatus) MarshalText() ([]byte, error) {
 		return []byte(s), nil
 	case ChairPostRideStatusReqStatusCARRYING:
 		return []byte(s), nil
+	case ChairPostRideStatusReqStatusMATCHING:
+		return []byte(s), nil
+	case ChairPostRideStatusReqStatusREJECT:
+		return []byte(s), nil
 	default:
 		return nil, errors.Errorf("invalid value: %q", s)
 	}
@@ -842,11 +1592,155 @@ func (s *ChairPostRideStatusReqStatus) UnmarshalText(data []byte) error {
 	case ChairPostRideStatusReqStatusCARRYING:
 		*s = ChairPostRideStatusReqStatusCARRYING
 		return nil
+	case ChairPostRideStatusReqStatusMATCHING:
+		*s = ChairPostRideStatusReqStatusMATCHING
+		return nil
+	case ChairPostRideStatusReqStatusREJECT:
+		*s = ChairPostRideStatusReqStatusREJECT
+		return nil
 	default:
 		return errors.Errorf("invalid value: %q", data)
 	}
 }
 
+// 椅子に割り当てられたライドの履歴.
+// Ref: #/components/schemas/ChairRides
+type ChairRides struct {
+	Rides []ChairRidesRidesItem `json:"rides"`
+	// 続きのライドを取得する時にcursorに指定する値。続きが無ければ含まれない.
+	NextCursor OptString `json:"next_cursor"`
+}
+
+// GetRides returns the value of Rides.
+func (s *ChairRides) GetRides() []ChairRidesRidesItem {
+	return s.Rides
+}
+
+// GetNextCursor returns the value of NextCursor.
+func (s *ChairRides) GetNextCursor() OptString {
+	return s.NextCursor
+}
+
+// SetRides sets the value of Rides.
+func (s *ChairRides) SetRides(val []ChairRidesRidesItem) {
+	s.Rides = val
+}
+
+// SetNextCursor sets the value of NextCursor.
+func (s *ChairRides) SetNextCursor(val OptString) {
+	s.NextCursor = val
+}
+
+func (*ChairRides) chairGetRidesRes()      {}
+func (*ChairRides) ownerGetChairRidesRes() {}
+
+type ChairRidesRidesItem struct {
+	// ライドID.
+	ID                    string     `json:"id"`
+	User                  User       `json:"user"`
+	PickupCoordinate      Coordinate `json:"pickup_coordinate"`
+	DestinationCoordinate Coordinate `json:"destination_coordinate"`
+	Status                RideStatus `json:"status"`
+	// 椅子の売上。完了したライドでは運賃、キャンセルされたライドではキャンセル料、それ以外は0.
+	Sales int `json:"sales"`
+	// ユーザーによる評価。まだ評価されていなければ含まれない.
+	Evaluation OptInt `json:"evaluation"`
+	// 配車要求日時 (UNIXミリ秒).
+	RequestedAt int64 `json:"requested_at"`
+	// 評価まで完了した日時 (UNIXミリ秒)。完了していなければ含まれない.
+	CompletedAt OptInt64 `json:"completed_at"`
+}
+
+// GetID returns the value of ID.
+func (s *ChairRidesRidesItem) GetID() string {
+	return s.ID
+}
+
+// GetUser returns the value of User.
+func (s *ChairRidesRidesItem) GetUser() User {
+	return s.User
+}
+
+// GetPickupCoordinate returns the value of PickupCoordinate.
+func (s *ChairRidesRidesItem) GetPickupCoordinate() Coordinate {
+	return s.PickupCoordinate
+}
+
+// GetDestinationCoordinate returns the value of DestinationCoordinate.
+func (s *ChairRidesRidesItem) GetDestinationCoordinate() Coordinate {
+	return s.DestinationCoordinate
+}
+
+// GetStatus returns the value of Status.
+func (s *ChairRidesRidesItem) GetStatus() RideStatus {
+	return s.Status
+}
+
+// GetSales returns the value of Sales.
+func (s *ChairRidesRidesItem) GetSales() int {
+	return s.Sales
+}
+
+// GetEvaluation returns the value of Evaluation.
+func (s *ChairRidesRidesItem) GetEvaluation() OptInt {
+	return s.Evaluation
+}
+
+// GetRequestedAt returns the value of RequestedAt.
+func (s *ChairRidesRidesItem) GetRequestedAt() int64 {
+	return s.RequestedAt
+}
+
+// GetCompletedAt returns the value of CompletedAt.
+func (s *ChairRidesRidesItem) GetCompletedAt() OptInt64 {
+	return s.CompletedAt
+}
+
+// SetID sets the value of ID.
+func (s *ChairRidesRidesItem) SetID(val string) {
+	s.ID = val
+}
+
+// SetUser sets the value of User.
+func (s *ChairRidesRidesItem) SetUser(val User) {
+	s.User = val
+}
+
+// SetPickupCoordinate sets the value of PickupCoordinate.
+func (s *ChairRidesRidesItem) SetPickupCoordinate(val Coordinate) {
+	s.PickupCoordinate = val
+}
+
+// SetDestinationCoordinate sets the value of DestinationCoordinate.
+func (s *ChairRidesRidesItem) SetDestinationCoordinate(val Coordinate) {
+	s.DestinationCoordinate = val
+}
+
+// SetStatus sets the value of Status.
+func (s *ChairRidesRidesItem) SetStatus(val RideStatus) {
+	s.Status = val
+}
+
+// SetSales sets the value of Sales.
+func (s *ChairRidesRidesItem) SetSales(val int) {
+	s.Sales = val
+}
+
+// SetEvaluation sets the value of Evaluation.
+func (s *ChairRidesRidesItem) SetEvaluation(val OptInt) {
+	s.Evaluation = val
+}
+
+// SetRequestedAt sets the value of RequestedAt.
+func (s *ChairRidesRidesItem) SetRequestedAt(val int64) {
+	s.RequestedAt = val
+}
+
+// SetCompletedAt sets the value of CompletedAt.
+func (s *ChairRidesRidesItem) SetCompletedAt(val OptInt64) {
+	s.CompletedAt = val
+}
+
 // 座標情報.
 // Ref: #/components/schemas/Coordinate
 type Coordinate struct {
@@ -876,6 +1770,67 @@ func (s *Coordinate) SetLongitude(val int) {
 	s.Longitude = val
 }
 
+// クーポンを付与する契機
+// - SIGNUP: ユーザー登録時に付与する
+// - INVITEE: 招待コードを使って登録したユーザーに付与する
+// - INVITER: 招待コードを使われたユーザーに付与する
+// - CODE: ユーザーがコードを入力して受け取る (デフォルト).
+// Ref: #/components/schemas/CouponCampaignKind
+type CouponCampaignKind string
+
+const (
+	CouponCampaignKindSIGNUP  CouponCampaignKind = "SIGNUP"
+	CouponCampaignKindINVITEE CouponCampaignKind = "INVITEE"
+	CouponCampaignKindINVITER CouponCampaignKind = "INVITER"
+	CouponCampaignKindCODE    CouponCampaignKind = "CODE"
+)
+
+// AllValues returns all CouponCampaignKind values.
+func (CouponCampaignKind) AllValues() []CouponCampaignKind {
+	return []CouponCampaignKind{
+		CouponCampaignKindSIGNUP,
+		CouponCampaignKindINVITEE,
+		CouponCampaignKindINVITER,
+		CouponCampaignKindCODE,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s CouponCampaignKind) MarshalText() ([]byte, error) {
+	switch s {
+	case CouponCampaignKindSIGNUP:
+		return []byte(s), nil
+	case CouponCampaignKindINVITEE:
+		return []byte(s), nil
+	case CouponCampaignKindINVITER:
+		return []byte(s), nil
+	case CouponCampaignKindCODE:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *CouponCampaignKind) UnmarshalText(data []byte) error {
+	switch CouponCampaignKind(data) {
+	case CouponCampaignKindSIGNUP:
+		*s = CouponCampaignKindSIGNUP
+		return nil
+	case CouponCampaignKindINVITEE:
+		*s = CouponCampaignKindINVITEE
+		return nil
+	case CouponCampaignKindINVITER:
+		*s = CouponCampaignKindINVITER
+		return nil
+	case CouponCampaignKindCODE:
+		*s = CouponCampaignKindCODE
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
 // Ref: #/components/schemas/Error
 type Error struct {
 	Message string `json:"message"`
@@ -891,37 +1846,434 @@ func (s *Error) SetMessage(val string) {
 	s.Message = val
 }
 
-func (*Error) appPostPaymentMethodsRes()     {}
-func (*Error) appPostRidesEstimatedFareRes() {}
-func (*Error) appPostUsersRes()              {}
-func (*Error) chairPostRideStatusRes()       {}
-func (*Error) ownerPostOwnersRes()           {}
+func (*Error) appGetRidePaymentRes()           {}
+func (*Error) appPostPaymentMethodDefaultRes() {}
+func (*Error) appPostPaymentMethodsRes()       {}
+func (*Error) appPostRidesEstimatedFareRes()   {}
+func (*Error) appPostUsersRes()                {}
+func (*Error) chairGetEarningsRes()            {}
+func (*Error) chairGetRidesRes()               {}
+func (*Error) chairPostActivityRes()           {}
+func (*Error) ownerGetRideRefundsRes()         {}
+func (*Error) ownerGetSalesExportRes()         {}
+func (*Error) ownerGetSalesTimeseriesRes()     {}
+func (*Error) ownerPostChairDeactivateRes()    {}
+func (*Error) ownerPostOwnersRes()             {}
+
+type InternalGetCouponCampaignsOK struct {
+	Campaigns []InternalGetCouponCampaignsOKCampaignsItem `json:"campaigns"`
+}
+
+// GetCampaigns returns the value of Campaigns.
+func (s *InternalGetCouponCampaignsOK) GetCampaigns() []InternalGetCouponCampaignsOKCampaignsItem {
+	return s.Campaigns
+}
+
+// SetCampaigns sets the value of Campaigns.
+func (s *InternalGetCouponCampaignsOK) SetCampaigns(val []InternalGetCouponCampaignsOKCampaignsItem) {
+	s.Campaigns = val
+}
+
+type InternalGetCouponCampaignsOKCampaignsItem struct {
+	// キャンペーンID.
+	ID   string             `json:"id"`
+	Kind CouponCampaignKind `json:"kind"`
+	// クーポンコードの接頭辞。CODEキャンペーンではユーザーが入力するコードそのもの.
+	CodePrefix string `json:"code_prefix"`
+	// 割引額。discount_percentageとどちらか一方を指定する.
+	DiscountAmount OptInt `json:"discount_amount"`
+	// 距離に応じた運賃に対する割引率(%)。discount_amountとどちらか一方を指定する.
+	DiscountPercentage OptInt `json:"discount_percentage"`
+	// 開始日時 (UNIXミリ秒)。指定しない場合はすぐに開始する.
+	StartsAt OptInt64 `json:"starts_at"`
+	// 終了日時
+	// (UNIXミリ秒)。指定しない場合は終了しない。終了したキャンペーンのクーポンはライドに使われない.
+	EndsAt OptInt64 `json:"ends_at"`
+	// 1ユーザーに付与できるクーポンの上限。INVITERキャンペーンでは1つの招待コードで招待できる人数の上限になる.
+	PerUserLimit OptInt `json:"per_user_limit"`
+	// 付与できるクーポンの総数の上限.
+	TotalLimit OptInt `json:"total_limit"`
+	// ライドに適用する優先度。大きいものから使う.
+	Priority int `json:"priority"`
+	// 付与したクーポンの数.
+	IssuedCount int `json:"issued_count"`
+	// 作成日時 (UNIXミリ秒).
+	CreatedAt int64 `json:"created_at"`
+}
 
-// InternalGetMatchingNoContent is response for InternalGetMatching operation.
-type InternalGetMatchingNoContent struct{}
+// GetID returns the value of ID.
+func (s *InternalGetCouponCampaignsOKCampaignsItem) GetID() string {
+	return s.ID
+}
 
-// NewOptAppPostPaymentMethodsReq returns new OptAppPostPaymentMethodsReq with value set to v.
-func NewOptAppPostPaymentMethodsReq(v AppPostPaymentMethodsReq) OptAppPostPaymentMethodsReq {
-	return OptAppPostPaymentMethodsReq{
-		Value: v,
-		Set:   true,
-	}
+// GetKind returns the value of Kind.
+func (s *InternalGetCouponCampaignsOKCampaignsItem) GetKind() CouponCampaignKind {
+	return s.Kind
 }
 
-// OptAppPostPaymentMethodsReq is optional AppPostPaymentMethodsReq.
-type OptAppPostPaymentMethodsReq struct {
-	Value AppPostPaymentMethodsReq
-	Set   bool
+// GetCodePrefix returns the value of CodePrefix.
+func (s *InternalGetCouponCampaignsOKCampaignsItem) GetCodePrefix() string {
+	return s.CodePrefix
 }
 
-// IsSet returns true if OptAppPostPaymentMethodsReq was set.
-func (o OptAppPostPaymentMethodsReq) IsSet() bool { return o.Set }
+// GetDiscountAmount returns the value of DiscountAmount.
+func (s *InternalGetCouponCampaignsOKCampaignsItem) GetDiscountAmount() OptInt {
+	return s.DiscountAmount
+}
 
-// Reset unsets value.
-func (o *OptAppPostPaymentMethodsReq) Reset() {
-	var v AppPostPaymentMethodsReq
-	o.Value = v
-	o.Set = false
+// GetDiscountPercentage returns the value of DiscountPercentage.
+func (s *InternalGetCouponCampaignsOKCampaignsItem) GetDiscountPercentage() OptInt {
+	return s.DiscountPercentage
+}
+
+// GetStartsAt returns the value of StartsAt.
+func (s *InternalGetCouponCampaignsOKCampaignsItem) GetStartsAt() OptInt64 {
+	return s.StartsAt
+}
+
+// GetEndsAt returns the value of EndsAt.
+func (s *InternalGetCouponCampaignsOKCampaignsItem) GetEndsAt() OptInt64 {
+	return s.EndsAt
+}
+
+// GetPerUserLimit returns the value of PerUserLimit.
+func (s *InternalGetCouponCampaignsOKCampaignsItem) GetPerUserLimit() OptInt {
+	return s.PerUserLimit
+}
+
+// GetTotalLimit returns the value of TotalLimit.
+func (s *InternalGetCouponCampaignsOKCampaignsItem) GetTotalLimit() OptInt {
+	return s.TotalLimit
+}
+
+// GetPriority returns the value of Priority.
+func (s *InternalGetCouponCampaignsOKCampaignsItem) GetPriority() int {
+	return s.Priority
+}
+
+// GetIssuedCount returns the value of IssuedCount.
+func (s *InternalGetCouponCampaignsOKCampaignsItem) GetIssuedCount() int {
+	return s.IssuedCount
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *InternalGetCouponCampaignsOKCampaignsItem) GetCreatedAt() int64 {
+	return s.CreatedAt
+}
+
+// SetID sets the value of ID.
+func (s *InternalGetCouponCampaignsOKCampaignsItem) SetID(val string) {
+	s.ID = val
+}
+
+// SetKind sets the value of Kind.
+func (s *InternalGetCouponCampaignsOKCampaignsItem) SetKind(val CouponCampaignKind) {
+	s.Kind = val
+}
+
+// SetCodePrefix sets the value of CodePrefix.
+func (s *InternalGetCouponCampaignsOKCampaignsItem) SetCodePrefix(val string) {
+	s.CodePrefix = val
+}
+
+// SetDiscountAmount sets the value of DiscountAmount.
+func (s *InternalGetCouponCampaignsOKCampaignsItem) SetDiscountAmount(val OptInt) {
+	s.DiscountAmount = val
+}
+
+// SetDiscountPercentage sets the value of DiscountPercentage.
+func (s *InternalGetCouponCampaignsOKCampaignsItem) SetDiscountPercentage(val OptInt) {
+	s.DiscountPercentage = val
+}
+
+// SetStartsAt sets the value of StartsAt.
+func (s *InternalGetCouponCampaignsOKCampaignsItem) SetStartsAt(val OptInt64) {
+	s.StartsAt = val
+}
+
+// SetEndsAt sets the value of EndsAt.
+func (s *InternalGetCouponCampaignsOKCampaignsItem) SetEndsAt(val OptInt64) {
+	s.EndsAt = val
+}
+
+// SetPerUserLimit sets the value of PerUserLimit.
+func (s *InternalGetCouponCampaignsOKCampaignsItem) SetPerUserLimit(val OptInt) {
+	s.PerUserLimit = val
+}
+
+// SetTotalLimit sets the value of TotalLimit.
+func (s *InternalGetCouponCampaignsOKCampaignsItem) SetTotalLimit(val OptInt) {
+	s.TotalLimit = val
+}
+
+// SetPriority sets the value of Priority.
+func (s *InternalGetCouponCampaignsOKCampaignsItem) SetPriority(val int) {
+	s.Priority = val
+}
+
+// SetIssuedCount sets the value of IssuedCount.
+func (s *InternalGetCouponCampaignsOKCampaignsItem) SetIssuedCount(val int) {
+	s.IssuedCount = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *InternalGetCouponCampaignsOKCampaignsItem) SetCreatedAt(val int64) {
+	s.CreatedAt = val
+}
+
+// InternalGetMatchingNoContent is response for InternalGetMatching operation.
+type InternalGetMatchingNoContent struct{}
+
+type InternalPostCouponCampaignsBadRequest Error
+
+func (*InternalPostCouponCampaignsBadRequest) internalPostCouponCampaignsRes() {}
+
+type InternalPostCouponCampaignsConflict Error
+
+func (*InternalPostCouponCampaignsConflict) internalPostCouponCampaignsRes() {}
+
+type InternalPostCouponCampaignsCreated struct {
+	// キャンペーンID.
+	ID string `json:"id"`
+}
+
+// GetID returns the value of ID.
+func (s *InternalPostCouponCampaignsCreated) GetID() string {
+	return s.ID
+}
+
+// SetID sets the value of ID.
+func (s *InternalPostCouponCampaignsCreated) SetID(val string) {
+	s.ID = val
+}
+
+func (*InternalPostCouponCampaignsCreated) internalPostCouponCampaignsRes() {}
+
+type InternalPostCouponCampaignsReq struct {
+	Kind OptCouponCampaignKind `json:"kind"`
+	// クーポンコードの接頭辞。CODEキャンペーンではユーザーが入力するコードそのもの.
+	CodePrefix string `json:"code_prefix"`
+	// 割引額。discount_percentageとどちらか一方を指定する.
+	DiscountAmount OptInt `json:"discount_amount"`
+	// 距離に応じた運賃に対する割引率(%)。discount_amountとどちらか一方を指定する.
+	DiscountPercentage OptInt `json:"discount_percentage"`
+	// 開始日時 (UNIXミリ秒)。指定しない場合はすぐに開始する.
+	StartsAt OptInt64 `json:"starts_at"`
+	// 終了日時
+	// (UNIXミリ秒)。指定しない場合は終了しない。終了したキャンペーンのクーポンはライドに使われない.
+	EndsAt OptInt64 `json:"ends_at"`
+	// 1ユーザーに付与できるクーポンの上限。INVITERキャンペーンでは1つの招待コードで招待できる人数の上限になる。CODEキャンペーンで指定しない場合は1になる.
+	PerUserLimit OptInt `json:"per_user_limit"`
+	// 付与できるクーポンの総数の上限.
+	TotalLimit OptInt `json:"total_limit"`
+	// ライドに適用する優先度。大きいものから使う.
+	Priority OptInt `json:"priority"`
+}
+
+// GetKind returns the value of Kind.
+func (s *InternalPostCouponCampaignsReq) GetKind() OptCouponCampaignKind {
+	return s.Kind
+}
+
+// GetCodePrefix returns the value of CodePrefix.
+func (s *InternalPostCouponCampaignsReq) GetCodePrefix() string {
+	return s.CodePrefix
+}
+
+// GetDiscountAmount returns the value of DiscountAmount.
+func (s *InternalPostCouponCampaignsReq) GetDiscountAmount() OptInt {
+	return s.DiscountAmount
+}
+
+// GetDiscountPercentage returns the value of DiscountPercentage.
+func (s *InternalPostCouponCampaignsReq) GetDiscountPercentage() OptInt {
+	return s.DiscountPercentage
+}
+
+// GetStartsAt returns the value of StartsAt.
+func (s *InternalPostCouponCampaignsReq) GetStartsAt() OptInt64 {
+	return s.StartsAt
+}
+
+// GetEndsAt returns the value of EndsAt.
+func (s *InternalPostCouponCampaignsReq) GetEndsAt() OptInt64 {
+	return s.EndsAt
+}
+
+// GetPerUserLimit returns the value of PerUserLimit.
+func (s *InternalPostCouponCampaignsReq) GetPerUserLimit() OptInt {
+	return s.PerUserLimit
+}
+
+// GetTotalLimit returns the value of TotalLimit.
+func (s *InternalPostCouponCampaignsReq) GetTotalLimit() OptInt {
+	return s.TotalLimit
+}
+
+// GetPriority returns the value of Priority.
+func (s *InternalPostCouponCampaignsReq) GetPriority() OptInt {
+	return s.Priority
+}
+
+// SetKind sets the value of Kind.
+func (s *InternalPostCouponCampaignsReq) SetKind(val OptCouponCampaignKind) {
+	s.Kind = val
+}
+
+// SetCodePrefix sets the value of CodePrefix.
+func (s *InternalPostCouponCampaignsReq) SetCodePrefix(val string) {
+	s.CodePrefix = val
+}
+
+// SetDiscountAmount sets the value of DiscountAmount.
+func (s *InternalPostCouponCampaignsReq) SetDiscountAmount(val OptInt) {
+	s.DiscountAmount = val
+}
+
+// SetDiscountPercentage sets the value of DiscountPercentage.
+func (s *InternalPostCouponCampaignsReq) SetDiscountPercentage(val OptInt) {
+	s.DiscountPercentage = val
+}
+
+// SetStartsAt sets the value of StartsAt.
+func (s *InternalPostCouponCampaignsReq) SetStartsAt(val OptInt64) {
+	s.StartsAt = val
+}
+
+// SetEndsAt sets the value of EndsAt.
+func (s *InternalPostCouponCampaignsReq) SetEndsAt(val OptInt64) {
+	s.EndsAt = val
+}
+
+// SetPerUserLimit sets the value of PerUserLimit.
+func (s *InternalPostCouponCampaignsReq) SetPerUserLimit(val OptInt) {
+	s.PerUserLimit = val
+}
+
+// SetTotalLimit sets the value of TotalLimit.
+func (s *InternalPostCouponCampaignsReq) SetTotalLimit(val OptInt) {
+	s.TotalLimit = val
+}
+
+// SetPriority sets the value of Priority.
+func (s *InternalPostCouponCampaignsReq) SetPriority(val OptInt) {
+	s.Priority = val
+}
+
+// NewOptAppPatchRideReq returns new OptAppPatchRideReq with value set to v.
+func NewOptAppPatchRideReq(v AppPatchRideReq) OptAppPatchRideReq {
+	return OptAppPatchRideReq{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptAppPatchRideReq is optional AppPatchRideReq.
+type OptAppPatchRideReq struct {
+	Value AppPatchRideReq
+	Set   bool
+}
+
+// IsSet returns true if OptAppPatchRideReq was set.
+func (o OptAppPatchRideReq) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptAppPatchRideReq) Reset() {
+	var v AppPatchRideReq
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptAppPatchRideReq) SetTo(v AppPatchRideReq) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptAppPatchRideReq) Get() (v AppPatchRideReq, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptAppPatchRideReq) Or(d AppPatchRideReq) AppPatchRideReq {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptAppPostCouponsReq returns new OptAppPostCouponsReq with value set to v.
+func NewOptAppPostCouponsReq(v AppPostCouponsReq) OptAppPostCouponsReq {
+	return OptAppPostCouponsReq{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptAppPostCouponsReq is optional AppPostCouponsReq.
+type OptAppPostCouponsReq struct {
+	Value AppPostCouponsReq
+	Set   bool
+}
+
+// IsSet returns true if OptAppPostCouponsReq was set.
+func (o OptAppPostCouponsReq) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptAppPostCouponsReq) Reset() {
+	var v AppPostCouponsReq
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptAppPostCouponsReq) SetTo(v AppPostCouponsReq) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptAppPostCouponsReq) Get() (v AppPostCouponsReq, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptAppPostCouponsReq) Or(d AppPostCouponsReq) AppPostCouponsReq {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptAppPostPaymentMethodsReq returns new OptAppPostPaymentMethodsReq with value set to v.
+func NewOptAppPostPaymentMethodsReq(v AppPostPaymentMethodsReq) OptAppPostPaymentMethodsReq {
+	return OptAppPostPaymentMethodsReq{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptAppPostPaymentMethodsReq is optional AppPostPaymentMethodsReq.
+type OptAppPostPaymentMethodsReq struct {
+	Value AppPostPaymentMethodsReq
+	Set   bool
+}
+
+// IsSet returns true if OptAppPostPaymentMethodsReq was set.
+func (o OptAppPostPaymentMethodsReq) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptAppPostPaymentMethodsReq) Reset() {
+	var v AppPostPaymentMethodsReq
+	o.Value = v
+	o.Set = false
 }
 
 // SetTo sets value to v.
@@ -1130,6 +2482,52 @@ func (o OptAppPostUsersReq) Or(d AppPostUsersReq) AppPostUsersReq {
 	return d
 }
 
+// NewOptBool returns new OptBool with value set to v.
+func NewOptBool(v bool) OptBool {
+	return OptBool{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptBool is optional bool.
+type OptBool struct {
+	Value bool
+	Set   bool
+}
+
+// IsSet returns true if OptBool was set.
+func (o OptBool) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptBool) Reset() {
+	var v bool
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptBool) SetTo(v bool) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptBool) Get() (v bool, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptBool) Or(d bool) bool {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
 // NewOptChairNotificationData returns new OptChairNotificationData with value set to v.
 func NewOptChairNotificationData(v ChairNotificationData) OptChairNotificationData {
 	return OptChairNotificationData{
@@ -1268,38 +2666,38 @@ func (o OptChairPostChairsReq) Or(d ChairPostChairsReq) ChairPostChairsReq {
 	return d
 }
 
-// NewOptChairPostRideStatusReq returns new OptChairPostRideStatusReq with value set to v.
-func NewOptChairPostRideStatusReq(v ChairPostRideStatusReq) OptChairPostRideStatusReq {
-	return OptChairPostRideStatusReq{
+// NewOptChairPostRideEvaluationReq returns new OptChairPostRideEvaluationReq with value set to v.
+func NewOptChairPostRideEvaluationReq(v ChairPostRideEvaluationReq) OptChairPostRideEvaluationReq {
+	return OptChairPostRideEvaluationReq{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptChairPostRideStatusReq is optional ChairPostRideStatusReq.
-type OptChairPostRideStatusReq struct {
-	Value ChairPostRideStatusReq
+// OptChairPostRideEvaluationReq is optional ChairPostRideEvaluationReq.
+type OptChairPostRideEvaluationReq struct {
+	Value ChairPostRideEvaluationReq
 	Set   bool
 }
 
-// IsSet returns true if OptChairPostRideStatusReq was set.
-func (o OptChairPostRideStatusReq) IsSet() bool { return o.Set }
+// IsSet returns true if OptChairPostRideEvaluationReq was set.
+func (o OptChairPostRideEvaluationReq) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptChairPostRideStatusReq) Reset() {
-	var v ChairPostRideStatusReq
+func (o *OptChairPostRideEvaluationReq) Reset() {
+	var v ChairPostRideEvaluationReq
 	o.Value = v
 	o.Set = false
 }
 
 // SetTo sets value to v.
-func (o *OptChairPostRideStatusReq) SetTo(v ChairPostRideStatusReq) {
+func (o *OptChairPostRideEvaluationReq) SetTo(v ChairPostRideEvaluationReq) {
 	o.Set = true
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptChairPostRideStatusReq) Get() (v ChairPostRideStatusReq, ok bool) {
+func (o OptChairPostRideEvaluationReq) Get() (v ChairPostRideEvaluationReq, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -1307,34 +2705,80 @@ func (o OptChairPostRideStatusReq) Get() (v ChairPostRideStatusReq, ok bool) {
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptChairPostRideStatusReq) Or(d ChairPostRideStatusReq) ChairPostRideStatusReq {
+func (o OptChairPostRideEvaluationReq) Or(d ChairPostRideEvaluationReq) ChairPostRideEvaluationReq {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptCoordinate returns new OptCoordinate with value set to v.
-func NewOptCoordinate(v Coordinate) OptCoordinate {
-	return OptCoordinate{
+// NewOptChairPostRideStatusReq returns new OptChairPostRideStatusReq with value set to v.
+func NewOptChairPostRideStatusReq(v ChairPostRideStatusReq) OptChairPostRideStatusReq {
+	return OptChairPostRideStatusReq{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptCoordinate is optional Coordinate.
-type OptCoordinate struct {
-	Value Coordinate
+// OptChairPostRideStatusReq is optional ChairPostRideStatusReq.
+type OptChairPostRideStatusReq struct {
+	Value ChairPostRideStatusReq
 	Set   bool
 }
 
-// IsSet returns true if OptCoordinate was set.
-func (o OptCoordinate) IsSet() bool { return o.Set }
+// IsSet returns true if OptChairPostRideStatusReq was set.
+func (o OptChairPostRideStatusReq) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptCoordinate) Reset() {
-	var v Coordinate
-	o.Value = v
+func (o *OptChairPostRideStatusReq) Reset() {
+	var v ChairPostRideStatusReq
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptChairPostRideStatusReq) SetTo(v ChairPostRideStatusReq) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptChairPostRideStatusReq) Get() (v ChairPostRideStatusReq, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptChairPostRideStatusReq) Or(d ChairPostRideStatusReq) ChairPostRideStatusReq {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptCoordinate returns new OptCoordinate with value set to v.
+func NewOptCoordinate(v Coordinate) OptCoordinate {
+	return OptCoordinate{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptCoordinate is optional Coordinate.
+type OptCoordinate struct {
+	Value Coordinate
+	Set   bool
+}
+
+// IsSet returns true if OptCoordinate was set.
+func (o OptCoordinate) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptCoordinate) Reset() {
+	var v Coordinate
+	o.Value = v
 	o.Set = false
 }
 
@@ -1360,6 +2804,98 @@ func (o OptCoordinate) Or(d Coordinate) Coordinate {
 	return d
 }
 
+// NewOptCouponCampaignKind returns new OptCouponCampaignKind with value set to v.
+func NewOptCouponCampaignKind(v CouponCampaignKind) OptCouponCampaignKind {
+	return OptCouponCampaignKind{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptCouponCampaignKind is optional CouponCampaignKind.
+type OptCouponCampaignKind struct {
+	Value CouponCampaignKind
+	Set   bool
+}
+
+// IsSet returns true if OptCouponCampaignKind was set.
+func (o OptCouponCampaignKind) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptCouponCampaignKind) Reset() {
+	var v CouponCampaignKind
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptCouponCampaignKind) SetTo(v CouponCampaignKind) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptCouponCampaignKind) Get() (v CouponCampaignKind, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptCouponCampaignKind) Or(d CouponCampaignKind) CouponCampaignKind {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptFloat64 returns new OptFloat64 with value set to v.
+func NewOptFloat64(v float64) OptFloat64 {
+	return OptFloat64{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptFloat64 is optional float64.
+type OptFloat64 struct {
+	Value float64
+	Set   bool
+}
+
+// IsSet returns true if OptFloat64 was set.
+func (o OptFloat64) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptFloat64) Reset() {
+	var v float64
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptFloat64) SetTo(v float64) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptFloat64) Get() (v float64, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptFloat64) Or(d float64) float64 {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
 // NewOptInt returns new OptInt with value set to v.
 func NewOptInt(v int) OptInt {
 	return OptInt{
@@ -1452,38 +2988,38 @@ func (o OptInt64) Or(d int64) int64 {
 	return d
 }
 
-// NewOptOwnerPostOwnersReq returns new OptOwnerPostOwnersReq with value set to v.
-func NewOptOwnerPostOwnersReq(v OwnerPostOwnersReq) OptOwnerPostOwnersReq {
-	return OptOwnerPostOwnersReq{
+// NewOptInternalPostCouponCampaignsReq returns new OptInternalPostCouponCampaignsReq with value set to v.
+func NewOptInternalPostCouponCampaignsReq(v InternalPostCouponCampaignsReq) OptInternalPostCouponCampaignsReq {
+	return OptInternalPostCouponCampaignsReq{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptOwnerPostOwnersReq is optional OwnerPostOwnersReq.
-type OptOwnerPostOwnersReq struct {
-	Value OwnerPostOwnersReq
+// OptInternalPostCouponCampaignsReq is optional InternalPostCouponCampaignsReq.
+type OptInternalPostCouponCampaignsReq struct {
+	Value InternalPostCouponCampaignsReq
 	Set   bool
 }
 
-// IsSet returns true if OptOwnerPostOwnersReq was set.
-func (o OptOwnerPostOwnersReq) IsSet() bool { return o.Set }
+// IsSet returns true if OptInternalPostCouponCampaignsReq was set.
+func (o OptInternalPostCouponCampaignsReq) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptOwnerPostOwnersReq) Reset() {
-	var v OwnerPostOwnersReq
+func (o *OptInternalPostCouponCampaignsReq) Reset() {
+	var v InternalPostCouponCampaignsReq
 	o.Value = v
 	o.Set = false
 }
 
 // SetTo sets value to v.
-func (o *OptOwnerPostOwnersReq) SetTo(v OwnerPostOwnersReq) {
+func (o *OptInternalPostCouponCampaignsReq) SetTo(v InternalPostCouponCampaignsReq) {
 	o.Set = true
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptOwnerPostOwnersReq) Get() (v OwnerPostOwnersReq, ok bool) {
+func (o OptInternalPostCouponCampaignsReq) Get() (v InternalPostCouponCampaignsReq, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -1491,45 +3027,45 @@ func (o OptOwnerPostOwnersReq) Get() (v OwnerPostOwnersReq, ok bool) {
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptOwnerPostOwnersReq) Or(d OwnerPostOwnersReq) OwnerPostOwnersReq {
+func (o OptInternalPostCouponCampaignsReq) Or(d InternalPostCouponCampaignsReq) InternalPostCouponCampaignsReq {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptPostInitializeReq returns new OptPostInitializeReq with value set to v.
-func NewOptPostInitializeReq(v PostInitializeReq) OptPostInitializeReq {
-	return OptPostInitializeReq{
+// NewOptOwnerGetSalesExportFormat returns new OptOwnerGetSalesExportFormat with value set to v.
+func NewOptOwnerGetSalesExportFormat(v OwnerGetSalesExportFormat) OptOwnerGetSalesExportFormat {
+	return OptOwnerGetSalesExportFormat{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptPostInitializeReq is optional PostInitializeReq.
-type OptPostInitializeReq struct {
-	Value PostInitializeReq
+// OptOwnerGetSalesExportFormat is optional OwnerGetSalesExportFormat.
+type OptOwnerGetSalesExportFormat struct {
+	Value OwnerGetSalesExportFormat
 	Set   bool
 }
 
-// IsSet returns true if OptPostInitializeReq was set.
-func (o OptPostInitializeReq) IsSet() bool { return o.Set }
+// IsSet returns true if OptOwnerGetSalesExportFormat was set.
+func (o OptOwnerGetSalesExportFormat) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptPostInitializeReq) Reset() {
-	var v PostInitializeReq
+func (o *OptOwnerGetSalesExportFormat) Reset() {
+	var v OwnerGetSalesExportFormat
 	o.Value = v
 	o.Set = false
 }
 
 // SetTo sets value to v.
-func (o *OptPostInitializeReq) SetTo(v PostInitializeReq) {
+func (o *OptOwnerGetSalesExportFormat) SetTo(v OwnerGetSalesExportFormat) {
 	o.Set = true
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptPostInitializeReq) Get() (v PostInitializeReq, ok bool) {
+func (o OptOwnerGetSalesExportFormat) Get() (v OwnerGetSalesExportFormat, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -1537,45 +3073,45 @@ func (o OptPostInitializeReq) Get() (v PostInitializeReq, ok bool) {
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptPostInitializeReq) Or(d PostInitializeReq) PostInitializeReq {
+func (o OptOwnerGetSalesExportFormat) Or(d OwnerGetSalesExportFormat) OwnerGetSalesExportFormat {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptString returns new OptString with value set to v.
-func NewOptString(v string) OptString {
-	return OptString{
+// NewOptOwnerGetSalesTimeseriesBucket returns new OptOwnerGetSalesTimeseriesBucket with value set to v.
+func NewOptOwnerGetSalesTimeseriesBucket(v OwnerGetSalesTimeseriesBucket) OptOwnerGetSalesTimeseriesBucket {
+	return OptOwnerGetSalesTimeseriesBucket{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptString is optional string.
-type OptString struct {
-	Value string
+// OptOwnerGetSalesTimeseriesBucket is optional OwnerGetSalesTimeseriesBucket.
+type OptOwnerGetSalesTimeseriesBucket struct {
+	Value OwnerGetSalesTimeseriesBucket
 	Set   bool
 }
 
-// IsSet returns true if OptString was set.
-func (o OptString) IsSet() bool { return o.Set }
+// IsSet returns true if OptOwnerGetSalesTimeseriesBucket was set.
+func (o OptOwnerGetSalesTimeseriesBucket) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptString) Reset() {
-	var v string
+func (o *OptOwnerGetSalesTimeseriesBucket) Reset() {
+	var v OwnerGetSalesTimeseriesBucket
 	o.Value = v
 	o.Set = false
 }
 
 // SetTo sets value to v.
-func (o *OptString) SetTo(v string) {
+func (o *OptOwnerGetSalesTimeseriesBucket) SetTo(v OwnerGetSalesTimeseriesBucket) {
 	o.Set = true
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptString) Get() (v string, ok bool) {
+func (o OptOwnerGetSalesTimeseriesBucket) Get() (v OwnerGetSalesTimeseriesBucket, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -1583,45 +3119,45 @@ func (o OptString) Get() (v string, ok bool) {
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptString) Or(d string) string {
+func (o OptOwnerGetSalesTimeseriesBucket) Or(d OwnerGetSalesTimeseriesBucket) OwnerGetSalesTimeseriesBucket {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptUserNotificationData returns new OptUserNotificationData with value set to v.
-func NewOptUserNotificationData(v UserNotificationData) OptUserNotificationData {
-	return OptUserNotificationData{
+// NewOptOwnerPatchChairReq returns new OptOwnerPatchChairReq with value set to v.
+func NewOptOwnerPatchChairReq(v OwnerPatchChairReq) OptOwnerPatchChairReq {
+	return OptOwnerPatchChairReq{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptUserNotificationData is optional UserNotificationData.
-type OptUserNotificationData struct {
-	Value UserNotificationData
+// OptOwnerPatchChairReq is optional OwnerPatchChairReq.
+type OptOwnerPatchChairReq struct {
+	Value OwnerPatchChairReq
 	Set   bool
 }
 
-// IsSet returns true if OptUserNotificationData was set.
-func (o OptUserNotificationData) IsSet() bool { return o.Set }
+// IsSet returns true if OptOwnerPatchChairReq was set.
+func (o OptOwnerPatchChairReq) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptUserNotificationData) Reset() {
-	var v UserNotificationData
+func (o *OptOwnerPatchChairReq) Reset() {
+	var v OwnerPatchChairReq
 	o.Value = v
 	o.Set = false
 }
 
 // SetTo sets value to v.
-func (o *OptUserNotificationData) SetTo(v UserNotificationData) {
+func (o *OptOwnerPatchChairReq) SetTo(v OwnerPatchChairReq) {
 	o.Set = true
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptUserNotificationData) Get() (v UserNotificationData, ok bool) {
+func (o OptOwnerPatchChairReq) Get() (v OwnerPatchChairReq, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -1629,45 +3165,45 @@ func (o OptUserNotificationData) Get() (v UserNotificationData, ok bool) {
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptUserNotificationData) Or(d UserNotificationData) UserNotificationData {
+func (o OptOwnerPatchChairReq) Or(d OwnerPatchChairReq) OwnerPatchChairReq {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptUserNotificationDataChair returns new OptUserNotificationDataChair with value set to v.
-func NewOptUserNotificationDataChair(v UserNotificationDataChair) OptUserNotificationDataChair {
-	return OptUserNotificationDataChair{
+// NewOptOwnerPostOwnersReq returns new OptOwnerPostOwnersReq with value set to v.
+func NewOptOwnerPostOwnersReq(v OwnerPostOwnersReq) OptOwnerPostOwnersReq {
+	return OptOwnerPostOwnersReq{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptUserNotificationDataChair is optional UserNotificationDataChair.
-type OptUserNotificationDataChair struct {
-	Value UserNotificationDataChair
+// OptOwnerPostOwnersReq is optional OwnerPostOwnersReq.
+type OptOwnerPostOwnersReq struct {
+	Value OwnerPostOwnersReq
 	Set   bool
 }
 
-// IsSet returns true if OptUserNotificationDataChair was set.
-func (o OptUserNotificationDataChair) IsSet() bool { return o.Set }
+// IsSet returns true if OptOwnerPostOwnersReq was set.
+func (o OptOwnerPostOwnersReq) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptUserNotificationDataChair) Reset() {
-	var v UserNotificationDataChair
+func (o *OptOwnerPostOwnersReq) Reset() {
+	var v OwnerPostOwnersReq
 	o.Value = v
 	o.Set = false
 }
 
 // SetTo sets value to v.
-func (o *OptUserNotificationDataChair) SetTo(v UserNotificationDataChair) {
+func (o *OptOwnerPostOwnersReq) SetTo(v OwnerPostOwnersReq) {
 	o.Set = true
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptUserNotificationDataChair) Get() (v UserNotificationDataChair, ok bool) {
+func (o OptOwnerPostOwnersReq) Get() (v OwnerPostOwnersReq, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -1675,361 +3211,1788 @@ func (o OptUserNotificationDataChair) Get() (v UserNotificationDataChair, ok boo
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptUserNotificationDataChair) Or(d UserNotificationDataChair) UserNotificationDataChair {
+func (o OptOwnerPostOwnersReq) Or(d OwnerPostOwnersReq) OwnerPostOwnersReq {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-type OwnerGetChairsOK struct {
-	Chairs []OwnerGetChairsOKChairsItem `json:"chairs"`
+// NewOptOwnerPostRideRefundReq returns new OptOwnerPostRideRefundReq with value set to v.
+func NewOptOwnerPostRideRefundReq(v OwnerPostRideRefundReq) OptOwnerPostRideRefundReq {
+	return OptOwnerPostRideRefundReq{
+		Value: v,
+		Set:   true,
+	}
 }
 
-// GetChairs returns the value of Chairs.
-func (s *OwnerGetChairsOK) GetChairs() []OwnerGetChairsOKChairsItem {
-	return s.Chairs
+// OptOwnerPostRideRefundReq is optional OwnerPostRideRefundReq.
+type OptOwnerPostRideRefundReq struct {
+	Value OwnerPostRideRefundReq
+	Set   bool
 }
 
-// SetChairs sets the value of Chairs.
-func (s *OwnerGetChairsOK) SetChairs(val []OwnerGetChairsOKChairsItem) {
-	s.Chairs = val
-}
+// IsSet returns true if OptOwnerPostRideRefundReq was set.
+func (o OptOwnerPostRideRefundReq) IsSet() bool { return o.Set }
 
-type OwnerGetChairsOKChairsItem struct {
-	// 椅子ID.
-	ID string `json:"id"`
-	// 椅子の名前.
-	Name string `json:"name"`
-	// 椅子のモデル.
-	Model string `json:"model"`
-	// 稼働中かどうか.
-	Active bool `json:"active"`
-	// 登録日時.
-	RegisteredAt int64 `json:"registered_at"`
-	// 総移動距離.
-	TotalDistance int `json:"total_distance"`
-	// 総移動距離の更新日時.
-	TotalDistanceUpdatedAt OptInt64 `json:"total_distance_updated_at"`
+// Reset unsets value.
+func (o *OptOwnerPostRideRefundReq) Reset() {
+	var v OwnerPostRideRefundReq
+	o.Value = v
+	o.Set = false
 }
 
-// GetID returns the value of ID.
-func (s *OwnerGetChairsOKChairsItem) GetID() string {
-	return s.ID
+// SetTo sets value to v.
+func (o *OptOwnerPostRideRefundReq) SetTo(v OwnerPostRideRefundReq) {
+	o.Set = true
+	o.Value = v
 }
 
-// GetName returns the value of Name.
-func (s *OwnerGetChairsOKChairsItem) GetName() string {
-	return s.Name
+// Get returns value and boolean that denotes whether value was set.
+func (o OptOwnerPostRideRefundReq) Get() (v OwnerPostRideRefundReq, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
 }
 
-// GetModel returns the value of Model.
-func (s *OwnerGetChairsOKChairsItem) GetModel() string {
-	return s.Model
+// Or returns value if set, or given parameter if does not.
+func (o OptOwnerPostRideRefundReq) Or(d OwnerPostRideRefundReq) OwnerPostRideRefundReq {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
 }
 
-// GetActive returns the value of Active.
-func (s *OwnerGetChairsOKChairsItem) GetActive() bool {
-	return s.Active
+// NewOptPostInitializeReq returns new OptPostInitializeReq with value set to v.
+func NewOptPostInitializeReq(v PostInitializeReq) OptPostInitializeReq {
+	return OptPostInitializeReq{
+		Value: v,
+		Set:   true,
+	}
 }
 
-// GetRegisteredAt returns the value of RegisteredAt.
-func (s *OwnerGetChairsOKChairsItem) GetRegisteredAt() int64 {
+// OptPostInitializeReq is optional PostInitializeReq.
+type OptPostInitializeReq struct {
+	Value PostInitializeReq
+	Set   bool
+}
+
+// IsSet returns true if OptPostInitializeReq was set.
+func (o OptPostInitializeReq) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptPostInitializeReq) Reset() {
+	var v PostInitializeReq
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptPostInitializeReq) SetTo(v PostInitializeReq) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptPostInitializeReq) Get() (v PostInitializeReq, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptPostInitializeReq) Or(d PostInitializeReq) PostInitializeReq {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptRidePayment returns new OptRidePayment with value set to v.
+func NewOptRidePayment(v RidePayment) OptRidePayment {
+	return OptRidePayment{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptRidePayment is optional RidePayment.
+type OptRidePayment struct {
+	Value RidePayment
+	Set   bool
+}
+
+// IsSet returns true if OptRidePayment was set.
+func (o OptRidePayment) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptRidePayment) Reset() {
+	var v RidePayment
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptRidePayment) SetTo(v RidePayment) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptRidePayment) Get() (v RidePayment, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptRidePayment) Or(d RidePayment) RidePayment {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptString returns new OptString with value set to v.
+func NewOptString(v string) OptString {
+	return OptString{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptString is optional string.
+type OptString struct {
+	Value string
+	Set   bool
+}
+
+// IsSet returns true if OptString was set.
+func (o OptString) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptString) Reset() {
+	var v string
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptString) SetTo(v string) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptString) Get() (v string, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptString) Or(d string) string {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptUserNotificationData returns new OptUserNotificationData with value set to v.
+func NewOptUserNotificationData(v UserNotificationData) OptUserNotificationData {
+	return OptUserNotificationData{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptUserNotificationData is optional UserNotificationData.
+type OptUserNotificationData struct {
+	Value UserNotificationData
+	Set   bool
+}
+
+// IsSet returns true if OptUserNotificationData was set.
+func (o OptUserNotificationData) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptUserNotificationData) Reset() {
+	var v UserNotificationData
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptUserNotificationData) SetTo(v UserNotificationData) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptUserNotificationData) Get() (v UserNotificationData, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptUserNotificationData) Or(d UserNotificationData) UserNotificationData {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptUserNotificationDataChair returns new OptUserNotificationDataChair with value set to v.
+func NewOptUserNotificationDataChair(v UserNotificationDataChair) OptUserNotificationDataChair {
+	return OptUserNotificationDataChair{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptUserNotificationDataChair is optional UserNotificationDataChair.
+type OptUserNotificationDataChair struct {
+	Value UserNotificationDataChair
+	Set   bool
+}
+
+// IsSet returns true if OptUserNotificationDataChair was set.
+func (o OptUserNotificationDataChair) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptUserNotificationDataChair) Reset() {
+	var v UserNotificationDataChair
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptUserNotificationDataChair) SetTo(v UserNotificationDataChair) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptUserNotificationDataChair) Get() (v UserNotificationDataChair, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptUserNotificationDataChair) Or(d UserNotificationDataChair) UserNotificationDataChair {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptUserNotificationDataPool returns new OptUserNotificationDataPool with value set to v.
+func NewOptUserNotificationDataPool(v UserNotificationDataPool) OptUserNotificationDataPool {
+	return OptUserNotificationDataPool{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptUserNotificationDataPool is optional UserNotificationDataPool.
+type OptUserNotificationDataPool struct {
+	Value UserNotificationDataPool
+	Set   bool
+}
+
+// IsSet returns true if OptUserNotificationDataPool was set.
+func (o OptUserNotificationDataPool) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptUserNotificationDataPool) Reset() {
+	var v UserNotificationDataPool
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptUserNotificationDataPool) SetTo(v UserNotificationDataPool) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptUserNotificationDataPool) Get() (v UserNotificationDataPool, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptUserNotificationDataPool) Or(d UserNotificationDataPool) UserNotificationDataPool {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptUserStats returns new OptUserStats with value set to v.
+func NewOptUserStats(v UserStats) OptUserStats {
+	return OptUserStats{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptUserStats is optional UserStats.
+type OptUserStats struct {
+	Value UserStats
+	Set   bool
+}
+
+// IsSet returns true if OptUserStats was set.
+func (o OptUserStats) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptUserStats) Reset() {
+	var v UserStats
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptUserStats) SetTo(v UserStats) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptUserStats) Get() (v UserStats, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptUserStats) Or(d UserStats) UserStats {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+type OwnerGetChairRidesBadRequest Error
+
+func (*OwnerGetChairRidesBadRequest) ownerGetChairRidesRes() {}
+
+type OwnerGetChairRidesNotFound Error
+
+func (*OwnerGetChairRidesNotFound) ownerGetChairRidesRes() {}
+
+type OwnerGetChairTrackBadRequest Error
+
+func (*OwnerGetChairTrackBadRequest) ownerGetChairTrackRes() {}
+
+type OwnerGetChairTrackNotFound Error
+
+func (*OwnerGetChairTrackNotFound) ownerGetChairTrackRes() {}
+
+type OwnerGetChairTrackOK struct {
+	// 記録した順の位置情報.
+	Points []TrackPoint `json:"points"`
+}
+
+// GetPoints returns the value of Points.
+func (s *OwnerGetChairTrackOK) GetPoints() []TrackPoint {
+	return s.Points
+}
+
+// SetPoints sets the value of Points.
+func (s *OwnerGetChairTrackOK) SetPoints(val []TrackPoint) {
+	s.Points = val
+}
+
+func (*OwnerGetChairTrackOK) ownerGetChairTrackRes() {}
+
+type OwnerGetChairsOK struct {
+	Chairs []OwnerGetChairsOKChairsItem `json:"chairs"`
+}
+
+// GetChairs returns the value of Chairs.
+func (s *OwnerGetChairsOK) GetChairs() []OwnerGetChairsOKChairsItem {
+	return s.Chairs
+}
+
+// SetChairs sets the value of Chairs.
+func (s *OwnerGetChairsOK) SetChairs(val []OwnerGetChairsOKChairsItem) {
+	s.Chairs = val
+}
+
+type OwnerGetChairsOKChairsItem struct {
+	// 椅子ID.
+	ID string `json:"id"`
+	// 椅子の名前.
+	Name string `json:"name"`
+	// 椅子のモデル.
+	Model string `json:"model"`
+	// 稼働中かどうか.
+	Active bool `json:"active"`
+	// 登録日時 (UNIXミリ秒).
+	RegisteredAt int64 `json:"registered_at"`
+	// 総移動距離.
+	TotalDistance int `json:"total_distance"`
+	// 総移動距離の更新日時 (UNIXミリ秒).
+	TotalDistanceUpdatedAt OptInt64 `json:"total_distance_updated_at"`
+	// 引退日時 (UNIXミリ秒)。引退していなければ含まれない.
+	RetiredAt OptInt64 `json:"retired_at"`
+}
+
+// GetID returns the value of ID.
+func (s *OwnerGetChairsOKChairsItem) GetID() string {
+	return s.ID
+}
+
+// GetName returns the value of Name.
+func (s *OwnerGetChairsOKChairsItem) GetName() string {
+	return s.Name
+}
+
+// GetModel returns the value of Model.
+func (s *OwnerGetChairsOKChairsItem) GetModel() string {
+	return s.Model
+}
+
+// GetActive returns the value of Active.
+func (s *OwnerGetChairsOKChairsItem) GetActive() bool {
+	return s.Active
+}
+
+// GetRegisteredAt returns the value of RegisteredAt.
+func (s *OwnerGetChairsOKChairsItem) GetRegisteredAt() int64 {
 	return s.RegisteredAt
 }
 
-// GetTotalDistance returns the value of TotalDistance.
-func (s *OwnerGetChairsOKChairsItem) GetTotalDistance() int {
-	return s.TotalDistance
+// GetTotalDistance returns the value of TotalDistance.
+func (s *OwnerGetChairsOKChairsItem) GetTotalDistance() int {
+	return s.TotalDistance
+}
+
+// GetTotalDistanceUpdatedAt returns the value of TotalDistanceUpdatedAt.
+func (s *OwnerGetChairsOKChairsItem) GetTotalDistanceUpdatedAt() OptInt64 {
+	return s.TotalDistanceUpdatedAt
+}
+
+// GetRetiredAt returns the value of RetiredAt.
+func (s *OwnerGetChairsOKChairsItem) GetRetiredAt() OptInt64 {
+	return s.RetiredAt
+}
+
+// SetID sets the value of ID.
+func (s *OwnerGetChairsOKChairsItem) SetID(val string) {
+	s.ID = val
+}
+
+// SetName sets the value of Name.
+func (s *OwnerGetChairsOKChairsItem) SetName(val string) {
+	s.Name = val
+}
+
+// SetModel sets the value of Model.
+func (s *OwnerGetChairsOKChairsItem) SetModel(val string) {
+	s.Model = val
+}
+
+// SetActive sets the value of Active.
+func (s *OwnerGetChairsOKChairsItem) SetActive(val bool) {
+	s.Active = val
+}
+
+// SetRegisteredAt sets the value of RegisteredAt.
+func (s *OwnerGetChairsOKChairsItem) SetRegisteredAt(val int64) {
+	s.RegisteredAt = val
+}
+
+// SetTotalDistance sets the value of TotalDistance.
+func (s *OwnerGetChairsOKChairsItem) SetTotalDistance(val int) {
+	s.TotalDistance = val
+}
+
+// SetTotalDistanceUpdatedAt sets the value of TotalDistanceUpdatedAt.
+func (s *OwnerGetChairsOKChairsItem) SetTotalDistanceUpdatedAt(val OptInt64) {
+	s.TotalDistanceUpdatedAt = val
+}
+
+// SetRetiredAt sets the value of RetiredAt.
+func (s *OwnerGetChairsOKChairsItem) SetRetiredAt(val OptInt64) {
+	s.RetiredAt = val
+}
+
+type OwnerGetFailedPaymentsOK struct {
+	Payments []OwnerGetFailedPaymentsOKPaymentsItem `json:"payments"`
+}
+
+// GetPayments returns the value of Payments.
+func (s *OwnerGetFailedPaymentsOK) GetPayments() []OwnerGetFailedPaymentsOKPaymentsItem {
+	return s.Payments
+}
+
+// SetPayments sets the value of Payments.
+func (s *OwnerGetFailedPaymentsOK) SetPayments(val []OwnerGetFailedPaymentsOKPaymentsItem) {
+	s.Payments = val
+}
+
+type OwnerGetFailedPaymentsOKPaymentsItem struct {
+	// ライドID.
+	RideID string `json:"ride_id"`
+	// 椅子ID.
+	ChairID string `json:"chair_id"`
+	// 椅子の名前.
+	ChairName string `json:"chair_name"`
+	// 決済の種類。RIDEは運賃もしくはキャンセル料、TIPはチップ.
+	Kind OwnerGetFailedPaymentsOKPaymentsItemKind `json:"kind"`
+	// 決済額.
+	Amount int `json:"amount"`
+	// 決済の状況.
+	Status OwnerGetFailedPaymentsOKPaymentsItemStatus `json:"status"`
+	// 決済マイクロサービスへのリクエスト回数.
+	Attempts int `json:"attempts"`
+	// 最後に失敗した時のエラー.
+	LastError OptString `json:"last_error"`
+	// 更新日時 (UNIXミリ秒).
+	UpdatedAt int64 `json:"updated_at"`
+}
+
+// GetRideID returns the value of RideID.
+func (s *OwnerGetFailedPaymentsOKPaymentsItem) GetRideID() string {
+	return s.RideID
+}
+
+// GetChairID returns the value of ChairID.
+func (s *OwnerGetFailedPaymentsOKPaymentsItem) GetChairID() string {
+	return s.ChairID
+}
+
+// GetChairName returns the value of ChairName.
+func (s *OwnerGetFailedPaymentsOKPaymentsItem) GetChairName() string {
+	return s.ChairName
+}
+
+// GetKind returns the value of Kind.
+func (s *OwnerGetFailedPaymentsOKPaymentsItem) GetKind() OwnerGetFailedPaymentsOKPaymentsItemKind {
+	return s.Kind
+}
+
+// GetAmount returns the value of Amount.
+func (s *OwnerGetFailedPaymentsOKPaymentsItem) GetAmount() int {
+	return s.Amount
+}
+
+// GetStatus returns the value of Status.
+func (s *OwnerGetFailedPaymentsOKPaymentsItem) GetStatus() OwnerGetFailedPaymentsOKPaymentsItemStatus {
+	return s.Status
+}
+
+// GetAttempts returns the value of Attempts.
+func (s *OwnerGetFailedPaymentsOKPaymentsItem) GetAttempts() int {
+	return s.Attempts
+}
+
+// GetLastError returns the value of LastError.
+func (s *OwnerGetFailedPaymentsOKPaymentsItem) GetLastError() OptString {
+	return s.LastError
+}
+
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *OwnerGetFailedPaymentsOKPaymentsItem) GetUpdatedAt() int64 {
+	return s.UpdatedAt
+}
+
+// SetRideID sets the value of RideID.
+func (s *OwnerGetFailedPaymentsOKPaymentsItem) SetRideID(val string) {
+	s.RideID = val
+}
+
+// SetChairID sets the value of ChairID.
+func (s *OwnerGetFailedPaymentsOKPaymentsItem) SetChairID(val string) {
+	s.ChairID = val
+}
+
+// SetChairName sets the value of ChairName.
+func (s *OwnerGetFailedPaymentsOKPaymentsItem) SetChairName(val string) {
+	s.ChairName = val
+}
+
+// SetKind sets the value of Kind.
+func (s *OwnerGetFailedPaymentsOKPaymentsItem) SetKind(val OwnerGetFailedPaymentsOKPaymentsItemKind) {
+	s.Kind = val
+}
+
+// SetAmount sets the value of Amount.
+func (s *OwnerGetFailedPaymentsOKPaymentsItem) SetAmount(val int) {
+	s.Amount = val
+}
+
+// SetStatus sets the value of Status.
+func (s *OwnerGetFailedPaymentsOKPaymentsItem) SetStatus(val OwnerGetFailedPaymentsOKPaymentsItemStatus) {
+	s.Status = val
+}
+
+// SetAttempts sets the value of Attempts.
+func (s *OwnerGetFailedPaymentsOKPaymentsItem) SetAttempts(val int) {
+	s.Attempts = val
+}
+
+// SetLastError sets the value of LastError.
+func (s *OwnerGetFailedPaymentsOKPaymentsItem) SetLastError(val OptString) {
+	s.LastError = val
+}
+
+// SetUpdatedAt sets the value of UpdatedAt.
+func (s *OwnerGetFailedPaymentsOKPaymentsItem) SetUpdatedAt(val int64) {
+	s.UpdatedAt = val
+}
+
+// 決済の種類。RIDEは運賃もしくはキャンセル料、TIPはチップ.
+type OwnerGetFailedPaymentsOKPaymentsItemKind string
+
+const (
+	OwnerGetFailedPaymentsOKPaymentsItemKindRIDE OwnerGetFailedPaymentsOKPaymentsItemKind = "RIDE"
+	OwnerGetFailedPaymentsOKPaymentsItemKindTIP  OwnerGetFailedPaymentsOKPaymentsItemKind = "TIP"
+)
+
+// AllValues returns all OwnerGetFailedPaymentsOKPaymentsItemKind values.
+func (OwnerGetFailedPaymentsOKPaymentsItemKind) AllValues() []OwnerGetFailedPaymentsOKPaymentsItemKind {
+	return []OwnerGetFailedPaymentsOKPaymentsItemKind{
+		OwnerGetFailedPaymentsOKPaymentsItemKindRIDE,
+		OwnerGetFailedPaymentsOKPaymentsItemKindTIP,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s OwnerGetFailedPaymentsOKPaymentsItemKind) MarshalText() ([]byte, error) {
+	switch s {
+	case OwnerGetFailedPaymentsOKPaymentsItemKindRIDE:
+		return []byte(s), nil
+	case OwnerGetFailedPaymentsOKPaymentsItemKindTIP:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *OwnerGetFailedPaymentsOKPaymentsItemKind) UnmarshalText(data []byte) error {
+	switch OwnerGetFailedPaymentsOKPaymentsItemKind(data) {
+	case OwnerGetFailedPaymentsOKPaymentsItemKindRIDE:
+		*s = OwnerGetFailedPaymentsOKPaymentsItemKindRIDE
+		return nil
+	case OwnerGetFailedPaymentsOKPaymentsItemKindTIP:
+		*s = OwnerGetFailedPaymentsOKPaymentsItemKindTIP
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// 決済の状況.
+type OwnerGetFailedPaymentsOKPaymentsItemStatus string
+
+const (
+	OwnerGetFailedPaymentsOKPaymentsItemStatusFAILED   OwnerGetFailedPaymentsOKPaymentsItemStatus = "FAILED"
+	OwnerGetFailedPaymentsOKPaymentsItemStatusREJECTED OwnerGetFailedPaymentsOKPaymentsItemStatus = "REJECTED"
+)
+
+// AllValues returns all OwnerGetFailedPaymentsOKPaymentsItemStatus values.
+func (OwnerGetFailedPaymentsOKPaymentsItemStatus) AllValues() []OwnerGetFailedPaymentsOKPaymentsItemStatus {
+	return []OwnerGetFailedPaymentsOKPaymentsItemStatus{
+		OwnerGetFailedPaymentsOKPaymentsItemStatusFAILED,
+		OwnerGetFailedPaymentsOKPaymentsItemStatusREJECTED,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s OwnerGetFailedPaymentsOKPaymentsItemStatus) MarshalText() ([]byte, error) {
+	switch s {
+	case OwnerGetFailedPaymentsOKPaymentsItemStatusFAILED:
+		return []byte(s), nil
+	case OwnerGetFailedPaymentsOKPaymentsItemStatusREJECTED:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *OwnerGetFailedPaymentsOKPaymentsItemStatus) UnmarshalText(data []byte) error {
+	switch OwnerGetFailedPaymentsOKPaymentsItemStatus(data) {
+	case OwnerGetFailedPaymentsOKPaymentsItemStatusFAILED:
+		*s = OwnerGetFailedPaymentsOKPaymentsItemStatusFAILED
+		return nil
+	case OwnerGetFailedPaymentsOKPaymentsItemStatusREJECTED:
+		*s = OwnerGetFailedPaymentsOKPaymentsItemStatusREJECTED
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type OwnerGetRideRefundsOK struct {
+	// 決済額。決済されていなければ0.
+	PaymentAmount int `json:"payment_amount"`
+	// 返金した額の合計。拒否された返金は含まない.
+	RefundedAmount int      `json:"refunded_amount"`
+	Refunds        []Refund `json:"refunds"`
+}
+
+// GetPaymentAmount returns the value of PaymentAmount.
+func (s *OwnerGetRideRefundsOK) GetPaymentAmount() int {
+	return s.PaymentAmount
+}
+
+// GetRefundedAmount returns the value of RefundedAmount.
+func (s *OwnerGetRideRefundsOK) GetRefundedAmount() int {
+	return s.RefundedAmount
+}
+
+// GetRefunds returns the value of Refunds.
+func (s *OwnerGetRideRefundsOK) GetRefunds() []Refund {
+	return s.Refunds
+}
+
+// SetPaymentAmount sets the value of PaymentAmount.
+func (s *OwnerGetRideRefundsOK) SetPaymentAmount(val int) {
+	s.PaymentAmount = val
+}
+
+// SetRefundedAmount sets the value of RefundedAmount.
+func (s *OwnerGetRideRefundsOK) SetRefundedAmount(val int) {
+	s.RefundedAmount = val
+}
+
+// SetRefunds sets the value of Refunds.
+func (s *OwnerGetRideRefundsOK) SetRefunds(val []Refund) {
+	s.Refunds = val
+}
+
+func (*OwnerGetRideRefundsOK) ownerGetRideRefundsRes() {}
+
+type OwnerGetRideRouteBadRequest Error
+
+func (*OwnerGetRideRouteBadRequest) ownerGetRideRouteRes() {}
+
+type OwnerGetRideRouteNotFound Error
+
+func (*OwnerGetRideRouteNotFound) ownerGetRideRouteRes() {}
+
+type OwnerGetRideRouteOK struct {
+	// ライドID.
+	RideID string `json:"ride_id"`
+	// ユーザーによる評価。まだ評価されていなければ含まれない.
+	Evaluation OptInt `json:"evaluation"`
+	// 椅子が配車位置へ向かい始めた日時
+	// (UNIXミリ秒)。まだ向かっていなければ含まれない.
+	EnrouteAt OptInt64 `json:"enroute_at"`
+	// 椅子が目的地に到着した日時
+	// (UNIXミリ秒)。まだ到着していなければ含まれない.
+	ArrivedAt OptInt64 `json:"arrived_at"`
+	// 記録した順の位置情報.
+	Points []TrackPoint `json:"points"`
+}
+
+// GetRideID returns the value of RideID.
+func (s *OwnerGetRideRouteOK) GetRideID() string {
+	return s.RideID
+}
+
+// GetEvaluation returns the value of Evaluation.
+func (s *OwnerGetRideRouteOK) GetEvaluation() OptInt {
+	return s.Evaluation
+}
+
+// GetEnrouteAt returns the value of EnrouteAt.
+func (s *OwnerGetRideRouteOK) GetEnrouteAt() OptInt64 {
+	return s.EnrouteAt
+}
+
+// GetArrivedAt returns the value of ArrivedAt.
+func (s *OwnerGetRideRouteOK) GetArrivedAt() OptInt64 {
+	return s.ArrivedAt
+}
+
+// GetPoints returns the value of Points.
+func (s *OwnerGetRideRouteOK) GetPoints() []TrackPoint {
+	return s.Points
+}
+
+// SetRideID sets the value of RideID.
+func (s *OwnerGetRideRouteOK) SetRideID(val string) {
+	s.RideID = val
+}
+
+// SetEvaluation sets the value of Evaluation.
+func (s *OwnerGetRideRouteOK) SetEvaluation(val OptInt) {
+	s.Evaluation = val
+}
+
+// SetEnrouteAt sets the value of EnrouteAt.
+func (s *OwnerGetRideRouteOK) SetEnrouteAt(val OptInt64) {
+	s.EnrouteAt = val
+}
+
+// SetArrivedAt sets the value of ArrivedAt.
+func (s *OwnerGetRideRouteOK) SetArrivedAt(val OptInt64) {
+	s.ArrivedAt = val
+}
+
+// SetPoints sets the value of Points.
+func (s *OwnerGetRideRouteOK) SetPoints(val []TrackPoint) {
+	s.Points = val
+}
+
+func (*OwnerGetRideRouteOK) ownerGetRideRouteRes() {}
+
+type OwnerGetSalesExportFormat string
+
+const (
+	OwnerGetSalesExportFormatCsv    OwnerGetSalesExportFormat = "csv"
+	OwnerGetSalesExportFormatNdjson OwnerGetSalesExportFormat = "ndjson"
+)
+
+// AllValues returns all OwnerGetSalesExportFormat values.
+func (OwnerGetSalesExportFormat) AllValues() []OwnerGetSalesExportFormat {
+	return []OwnerGetSalesExportFormat{
+		OwnerGetSalesExportFormatCsv,
+		OwnerGetSalesExportFormatNdjson,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s OwnerGetSalesExportFormat) MarshalText() ([]byte, error) {
+	switch s {
+	case OwnerGetSalesExportFormatCsv:
+		return []byte(s), nil
+	case OwnerGetSalesExportFormatNdjson:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *OwnerGetSalesExportFormat) UnmarshalText(data []byte) error {
+	switch OwnerGetSalesExportFormat(data) {
+	case OwnerGetSalesExportFormatCsv:
+		*s = OwnerGetSalesExportFormatCsv
+		return nil
+	case OwnerGetSalesExportFormatNdjson:
+		*s = OwnerGetSalesExportFormatNdjson
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type OwnerGetSalesExportOKApplicationXNdjson struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s OwnerGetSalesExportOKApplicationXNdjson) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+func (*OwnerGetSalesExportOKApplicationXNdjson) ownerGetSalesExportRes() {}
+
+type OwnerGetSalesExportOKTextCsv struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s OwnerGetSalesExportOKTextCsv) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+func (*OwnerGetSalesExportOKTextCsv) ownerGetSalesExportRes() {}
+
+type OwnerGetSalesOK struct {
+	// オーナーが管理する椅子全体の売上.
+	TotalSales int `json:"total_sales"`
+	// オーナーが管理する椅子全体のチップ.
+	TotalTips int `json:"total_tips"`
+	// 椅子ごとの売上情報.
+	Chairs []OwnerGetSalesOKChairsItem `json:"chairs"`
+	// モデルごとの売上情報.
+	Models []OwnerGetSalesOKModelsItem `json:"models"`
+}
+
+// GetTotalSales returns the value of TotalSales.
+func (s *OwnerGetSalesOK) GetTotalSales() int {
+	return s.TotalSales
+}
+
+// GetTotalTips returns the value of TotalTips.
+func (s *OwnerGetSalesOK) GetTotalTips() int {
+	return s.TotalTips
+}
+
+// GetChairs returns the value of Chairs.
+func (s *OwnerGetSalesOK) GetChairs() []OwnerGetSalesOKChairsItem {
+	return s.Chairs
+}
+
+// GetModels returns the value of Models.
+func (s *OwnerGetSalesOK) GetModels() []OwnerGetSalesOKModelsItem {
+	return s.Models
+}
+
+// SetTotalSales sets the value of TotalSales.
+func (s *OwnerGetSalesOK) SetTotalSales(val int) {
+	s.TotalSales = val
+}
+
+// SetTotalTips sets the value of TotalTips.
+func (s *OwnerGetSalesOK) SetTotalTips(val int) {
+	s.TotalTips = val
+}
+
+// SetChairs sets the value of Chairs.
+func (s *OwnerGetSalesOK) SetChairs(val []OwnerGetSalesOKChairsItem) {
+	s.Chairs = val
+}
+
+// SetModels sets the value of Models.
+func (s *OwnerGetSalesOK) SetModels(val []OwnerGetSalesOKModelsItem) {
+	s.Models = val
+}
+
+type OwnerGetSalesOKChairsItem struct {
+	// 椅子ID.
+	ID string `json:"id"`
+	// 椅子の名前.
+	Name string `json:"name"`
+	// 椅子ごとの売上.
+	Sales int `json:"sales"`
+	// 椅子ごとのチップ.
+	Tips int `json:"tips"`
+}
+
+// GetID returns the value of ID.
+func (s *OwnerGetSalesOKChairsItem) GetID() string {
+	return s.ID
+}
+
+// GetName returns the value of Name.
+func (s *OwnerGetSalesOKChairsItem) GetName() string {
+	return s.Name
+}
+
+// GetSales returns the value of Sales.
+func (s *OwnerGetSalesOKChairsItem) GetSales() int {
+	return s.Sales
+}
+
+// GetTips returns the value of Tips.
+func (s *OwnerGetSalesOKChairsItem) GetTips() int {
+	return s.Tips
+}
+
+// SetID sets the value of ID.
+func (s *OwnerGetSalesOKChairsItem) SetID(val string) {
+	s.ID = val
+}
+
+// SetName sets the value of Name.
+func (s *OwnerGetSalesOKChairsItem) SetName(val string) {
+	s.Name = val
+}
+
+// SetSales sets the value of Sales.
+func (s *OwnerGetSalesOKChairsItem) SetSales(val int) {
+	s.Sales = val
+}
+
+// SetTips sets the value of Tips.
+func (s *OwnerGetSalesOKChairsItem) SetTips(val int) {
+	s.Tips = val
+}
+
+type OwnerGetSalesOKModelsItem struct {
+	// モデル.
+	Model string `json:"model"`
+	// モデルごとの売上.
+	Sales int `json:"sales"`
+	// モデルごとのチップ.
+	Tips int `json:"tips"`
+}
+
+// GetModel returns the value of Model.
+func (s *OwnerGetSalesOKModelsItem) GetModel() string {
+	return s.Model
+}
+
+// GetSales returns the value of Sales.
+func (s *OwnerGetSalesOKModelsItem) GetSales() int {
+	return s.Sales
+}
+
+// GetTips returns the value of Tips.
+func (s *OwnerGetSalesOKModelsItem) GetTips() int {
+	return s.Tips
+}
+
+// SetModel sets the value of Model.
+func (s *OwnerGetSalesOKModelsItem) SetModel(val string) {
+	s.Model = val
+}
+
+// SetSales sets the value of Sales.
+func (s *OwnerGetSalesOKModelsItem) SetSales(val int) {
+	s.Sales = val
+}
+
+// SetTips sets the value of Tips.
+func (s *OwnerGetSalesOKModelsItem) SetTips(val int) {
+	s.Tips = val
+}
+
+type OwnerGetSalesTimeseriesBucket string
+
+const (
+	OwnerGetSalesTimeseriesBucketHour OwnerGetSalesTimeseriesBucket = "hour"
+	OwnerGetSalesTimeseriesBucketDay  OwnerGetSalesTimeseriesBucket = "day"
+	OwnerGetSalesTimeseriesBucketWeek OwnerGetSalesTimeseriesBucket = "week"
+)
+
+// AllValues returns all OwnerGetSalesTimeseriesBucket values.
+func (OwnerGetSalesTimeseriesBucket) AllValues() []OwnerGetSalesTimeseriesBucket {
+	return []OwnerGetSalesTimeseriesBucket{
+		OwnerGetSalesTimeseriesBucketHour,
+		OwnerGetSalesTimeseriesBucketDay,
+		OwnerGetSalesTimeseriesBucketWeek,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s OwnerGetSalesTimeseriesBucket) MarshalText() ([]byte, error) {
+	switch s {
+	case OwnerGetSalesTimeseriesBucketHour:
+		return []byte(s), nil
+	case OwnerGetSalesTimeseriesBucketDay:
+		return []byte(s), nil
+	case OwnerGetSalesTimeseriesBucketWeek:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *OwnerGetSalesTimeseriesBucket) UnmarshalText(data []byte) error {
+	switch OwnerGetSalesTimeseriesBucket(data) {
+	case OwnerGetSalesTimeseriesBucketHour:
+		*s = OwnerGetSalesTimeseriesBucketHour
+		return nil
+	case OwnerGetSalesTimeseriesBucketDay:
+		*s = OwnerGetSalesTimeseriesBucketDay
+		return nil
+	case OwnerGetSalesTimeseriesBucketWeek:
+		*s = OwnerGetSalesTimeseriesBucketWeek
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type OwnerGetSalesTimeseriesOK struct {
+	// 集計単位.
+	Bucket string `json:"bucket"`
+	// 椅子ごとの売上の推移.
+	Chairs []OwnerGetSalesTimeseriesOKChairsItem `json:"chairs"`
+	// モデルごとの売上の推移.
+	Models []OwnerGetSalesTimeseriesOKModelsItem `json:"models"`
+}
+
+// GetBucket returns the value of Bucket.
+func (s *OwnerGetSalesTimeseriesOK) GetBucket() string {
+	return s.Bucket
+}
+
+// GetChairs returns the value of Chairs.
+func (s *OwnerGetSalesTimeseriesOK) GetChairs() []OwnerGetSalesTimeseriesOKChairsItem {
+	return s.Chairs
+}
+
+// GetModels returns the value of Models.
+func (s *OwnerGetSalesTimeseriesOK) GetModels() []OwnerGetSalesTimeseriesOKModelsItem {
+	return s.Models
+}
+
+// SetBucket sets the value of Bucket.
+func (s *OwnerGetSalesTimeseriesOK) SetBucket(val string) {
+	s.Bucket = val
+}
+
+// SetChairs sets the value of Chairs.
+func (s *OwnerGetSalesTimeseriesOK) SetChairs(val []OwnerGetSalesTimeseriesOKChairsItem) {
+	s.Chairs = val
+}
+
+// SetModels sets the value of Models.
+func (s *OwnerGetSalesTimeseriesOK) SetModels(val []OwnerGetSalesTimeseriesOKModelsItem) {
+	s.Models = val
+}
+
+func (*OwnerGetSalesTimeseriesOK) ownerGetSalesTimeseriesRes() {}
+
+type OwnerGetSalesTimeseriesOKChairsItem struct {
+	// 椅子ID.
+	ID string `json:"id"`
+	// 椅子の名前.
+	Name string `json:"name"`
+	// 椅子のモデル.
+	Model  string       `json:"model"`
+	Series []SalesPoint `json:"series"`
 }
 
-// GetTotalDistanceUpdatedAt returns the value of TotalDistanceUpdatedAt.
-func (s *OwnerGetChairsOKChairsItem) GetTotalDistanceUpdatedAt() OptInt64 {
-	return s.TotalDistanceUpdatedAt
+// GetID returns the value of ID.
+func (s *OwnerGetSalesTimeseriesOKChairsItem) GetID() string {
+	return s.ID
+}
+
+// GetName returns the value of Name.
+func (s *OwnerGetSalesTimeseriesOKChairsItem) GetName() string {
+	return s.Name
+}
+
+// GetModel returns the value of Model.
+func (s *OwnerGetSalesTimeseriesOKChairsItem) GetModel() string {
+	return s.Model
+}
+
+// GetSeries returns the value of Series.
+func (s *OwnerGetSalesTimeseriesOKChairsItem) GetSeries() []SalesPoint {
+	return s.Series
 }
 
 // SetID sets the value of ID.
-func (s *OwnerGetChairsOKChairsItem) SetID(val string) {
+func (s *OwnerGetSalesTimeseriesOKChairsItem) SetID(val string) {
 	s.ID = val
 }
 
 // SetName sets the value of Name.
-func (s *OwnerGetChairsOKChairsItem) SetName(val string) {
+func (s *OwnerGetSalesTimeseriesOKChairsItem) SetName(val string) {
 	s.Name = val
 }
 
 // SetModel sets the value of Model.
-func (s *OwnerGetChairsOKChairsItem) SetModel(val string) {
+func (s *OwnerGetSalesTimeseriesOKChairsItem) SetModel(val string) {
 	s.Model = val
 }
 
-// SetActive sets the value of Active.
-func (s *OwnerGetChairsOKChairsItem) SetActive(val bool) {
-	s.Active = val
+// SetSeries sets the value of Series.
+func (s *OwnerGetSalesTimeseriesOKChairsItem) SetSeries(val []SalesPoint) {
+	s.Series = val
 }
 
-// SetRegisteredAt sets the value of RegisteredAt.
-func (s *OwnerGetChairsOKChairsItem) SetRegisteredAt(val int64) {
-	s.RegisteredAt = val
+type OwnerGetSalesTimeseriesOKModelsItem struct {
+	// モデル.
+	Model  string       `json:"model"`
+	Series []SalesPoint `json:"series"`
 }
 
-// SetTotalDistance sets the value of TotalDistance.
-func (s *OwnerGetChairsOKChairsItem) SetTotalDistance(val int) {
-	s.TotalDistance = val
+// GetModel returns the value of Model.
+func (s *OwnerGetSalesTimeseriesOKModelsItem) GetModel() string {
+	return s.Model
 }
 
-// SetTotalDistanceUpdatedAt sets the value of TotalDistanceUpdatedAt.
-func (s *OwnerGetChairsOKChairsItem) SetTotalDistanceUpdatedAt(val OptInt64) {
-	s.TotalDistanceUpdatedAt = val
+// GetSeries returns the value of Series.
+func (s *OwnerGetSalesTimeseriesOKModelsItem) GetSeries() []SalesPoint {
+	return s.Series
 }
 
-type OwnerGetSalesOK struct {
-	// オーナーが管理する椅子全体の売上.
-	TotalSales int `json:"total_sales"`
-	// 椅子ごとの売上情報.
-	Chairs []OwnerGetSalesOKChairsItem `json:"chairs"`
-	// モデルごとの売上情報.
-	Models []OwnerGetSalesOKModelsItem `json:"models"`
+// SetModel sets the value of Model.
+func (s *OwnerGetSalesTimeseriesOKModelsItem) SetModel(val string) {
+	s.Model = val
 }
 
-// GetTotalSales returns the value of TotalSales.
-func (s *OwnerGetSalesOK) GetTotalSales() int {
-	return s.TotalSales
+// SetSeries sets the value of Series.
+func (s *OwnerGetSalesTimeseriesOKModelsItem) SetSeries(val []SalesPoint) {
+	s.Series = val
 }
 
-// GetChairs returns the value of Chairs.
-func (s *OwnerGetSalesOK) GetChairs() []OwnerGetSalesOKChairsItem {
-	return s.Chairs
+type OwnerPatchChairBadRequest Error
+
+func (*OwnerPatchChairBadRequest) ownerPatchChairRes() {}
+
+// OwnerPatchChairNoContent is response for OwnerPatchChair operation.
+type OwnerPatchChairNoContent struct{}
+
+func (*OwnerPatchChairNoContent) ownerPatchChairRes() {}
+
+type OwnerPatchChairNotFound Error
+
+func (*OwnerPatchChairNotFound) ownerPatchChairRes() {}
+
+type OwnerPatchChairReq struct {
+	// 椅子の名前.
+	Name OptString `json:"name"`
+	// 椅子のモデル.
+	Model OptString `json:"model"`
 }
 
-// GetModels returns the value of Models.
-func (s *OwnerGetSalesOK) GetModels() []OwnerGetSalesOKModelsItem {
-	return s.Models
+// GetName returns the value of Name.
+func (s *OwnerPatchChairReq) GetName() OptString {
+	return s.Name
 }
 
-// SetTotalSales sets the value of TotalSales.
-func (s *OwnerGetSalesOK) SetTotalSales(val int) {
-	s.TotalSales = val
+// GetModel returns the value of Model.
+func (s *OwnerPatchChairReq) GetModel() OptString {
+	return s.Model
 }
 
-// SetChairs sets the value of Chairs.
-func (s *OwnerGetSalesOK) SetChairs(val []OwnerGetSalesOKChairsItem) {
-	s.Chairs = val
+// SetName sets the value of Name.
+func (s *OwnerPatchChairReq) SetName(val OptString) {
+	s.Name = val
 }
 
-// SetModels sets the value of Models.
-func (s *OwnerGetSalesOK) SetModels(val []OwnerGetSalesOKModelsItem) {
-	s.Models = val
+// SetModel sets the value of Model.
+func (s *OwnerPatchChairReq) SetModel(val OptString) {
+	s.Model = val
 }
 
-type OwnerGetSalesOKChairsItem struct {
-	// 椅子ID.
+type OwnerPostChairAccessTokenBadRequest Error
+
+func (*OwnerPostChairAccessTokenBadRequest) ownerPostChairAccessTokenRes() {}
+
+type OwnerPostChairAccessTokenNotFound Error
+
+func (*OwnerPostChairAccessTokenNotFound) ownerPostChairAccessTokenRes() {}
+
+type OwnerPostChairAccessTokenOK struct {
+	// 椅子の新しいアクセストークン.
+	AccessToken string `json:"access_token"`
+}
+
+// GetAccessToken returns the value of AccessToken.
+func (s *OwnerPostChairAccessTokenOK) GetAccessToken() string {
+	return s.AccessToken
+}
+
+// SetAccessToken sets the value of AccessToken.
+func (s *OwnerPostChairAccessTokenOK) SetAccessToken(val string) {
+	s.AccessToken = val
+}
+
+func (*OwnerPostChairAccessTokenOK) ownerPostChairAccessTokenRes() {}
+
+// OwnerPostChairDeactivateNoContent is response for OwnerPostChairDeactivate operation.
+type OwnerPostChairDeactivateNoContent struct{}
+
+func (*OwnerPostChairDeactivateNoContent) ownerPostChairDeactivateRes() {}
+
+type OwnerPostChairRetireBadRequest Error
+
+func (*OwnerPostChairRetireBadRequest) ownerPostChairRetireRes() {}
+
+type OwnerPostChairRetireConflict Error
+
+func (*OwnerPostChairRetireConflict) ownerPostChairRetireRes() {}
+
+// OwnerPostChairRetireNoContent is response for OwnerPostChairRetire operation.
+type OwnerPostChairRetireNoContent struct{}
+
+func (*OwnerPostChairRetireNoContent) ownerPostChairRetireRes() {}
+
+type OwnerPostChairRetireNotFound Error
+
+func (*OwnerPostChairRetireNotFound) ownerPostChairRetireRes() {}
+
+type OwnerPostOwnersCreated struct {
+	// オーナーID.
 	ID string `json:"id"`
-	// 椅子名.
-	Name string `json:"name"`
-	// 椅子ごとの売上.
-	Sales int `json:"sales"`
+	// 椅子をオーナーに紐づけるための椅子登録用トークン.
+	ChairRegisterToken string `json:"chair_register_token"`
 }
 
 // GetID returns the value of ID.
-func (s *OwnerGetSalesOKChairsItem) GetID() string {
+func (s *OwnerPostOwnersCreated) GetID() string {
 	return s.ID
 }
 
-// GetName returns the value of Name.
-func (s *OwnerGetSalesOKChairsItem) GetName() string {
-	return s.Name
-}
-
-// GetSales returns the value of Sales.
-func (s *OwnerGetSalesOKChairsItem) GetSales() int {
-	return s.Sales
+// GetChairRegisterToken returns the value of ChairRegisterToken.
+func (s *OwnerPostOwnersCreated) GetChairRegisterToken() string {
+	return s.ChairRegisterToken
 }
 
 // SetID sets the value of ID.
-func (s *OwnerGetSalesOKChairsItem) SetID(val string) {
+func (s *OwnerPostOwnersCreated) SetID(val string) {
 	s.ID = val
 }
 
+// SetChairRegisterToken sets the value of ChairRegisterToken.
+func (s *OwnerPostOwnersCreated) SetChairRegisterToken(val string) {
+	s.ChairRegisterToken = val
+}
+
+// OwnerPostOwnersCreatedHeaders wraps OwnerPostOwnersCreated with response headers.
+type OwnerPostOwnersCreatedHeaders struct {
+	SetCookie OptString
+	Response  OwnerPostOwnersCreated
+}
+
+// GetSetCookie returns the value of SetCookie.
+func (s *OwnerPostOwnersCreatedHeaders) GetSetCookie() OptString {
+	return s.SetCookie
+}
+
+// GetResponse returns the value of Response.
+func (s *OwnerPostOwnersCreatedHeaders) GetResponse() OwnerPostOwnersCreated {
+	return s.Response
+}
+
+// SetSetCookie sets the value of SetCookie.
+func (s *OwnerPostOwnersCreatedHeaders) SetSetCookie(val OptString) {
+	s.SetCookie = val
+}
+
+// SetResponse sets the value of Response.
+func (s *OwnerPostOwnersCreatedHeaders) SetResponse(val OwnerPostOwnersCreated) {
+	s.Response = val
+}
+
+func (*OwnerPostOwnersCreatedHeaders) ownerPostOwnersRes() {}
+
+type OwnerPostOwnersReq struct {
+	// オーナー名.
+	Name string `json:"name"`
+}
+
+// GetName returns the value of Name.
+func (s *OwnerPostOwnersReq) GetName() string {
+	return s.Name
+}
+
 // SetName sets the value of Name.
-func (s *OwnerGetSalesOKChairsItem) SetName(val string) {
+func (s *OwnerPostOwnersReq) SetName(val string) {
 	s.Name = val
 }
 
-// SetSales sets the value of Sales.
-func (s *OwnerGetSalesOKChairsItem) SetSales(val int) {
-	s.Sales = val
+type OwnerPostRideRefundBadRequest Error
+
+func (*OwnerPostRideRefundBadRequest) ownerPostRideRefundRes() {}
+
+type OwnerPostRideRefundConflict Error
+
+func (*OwnerPostRideRefundConflict) ownerPostRideRefundRes() {}
+
+type OwnerPostRideRefundNotFound Error
+
+func (*OwnerPostRideRefundNotFound) ownerPostRideRefundRes() {}
+
+type OwnerPostRideRefundReq struct {
+	// 返金額。指定しなければ、まだ返金していない残りを全て返金する.
+	Amount OptInt `json:"amount"`
+	// 返金の理由.
+	Reason OptString `json:"reason"`
+}
+
+// GetAmount returns the value of Amount.
+func (s *OwnerPostRideRefundReq) GetAmount() OptInt {
+	return s.Amount
+}
+
+// GetReason returns the value of Reason.
+func (s *OwnerPostRideRefundReq) GetReason() OptString {
+	return s.Reason
+}
+
+// SetAmount sets the value of Amount.
+func (s *OwnerPostRideRefundReq) SetAmount(val OptInt) {
+	s.Amount = val
+}
+
+// SetReason sets the value of Reason.
+func (s *OwnerPostRideRefundReq) SetReason(val OptString) {
+	s.Reason = val
+}
+
+type PostInitializeOK struct {
+	// 実装言語
+	// - go
+	// - perl
+	// - php
+	// - python
+	// - ruby
+	// - rust
+	// - node.
+	Language string `json:"language"`
+}
+
+// GetLanguage returns the value of Language.
+func (s *PostInitializeOK) GetLanguage() string {
+	return s.Language
+}
+
+// SetLanguage sets the value of Language.
+func (s *PostInitializeOK) SetLanguage(val string) {
+	s.Language = val
+}
+
+type PostInitializeReq struct {
+	// 決済サーバーアドレス.
+	PaymentServer string `json:"payment_server"`
+}
+
+// GetPaymentServer returns the value of PaymentServer.
+func (s *PostInitializeReq) GetPaymentServer() string {
+	return s.PaymentServer
+}
+
+// SetPaymentServer sets the value of PaymentServer.
+func (s *PostInitializeReq) SetPaymentServer(val string) {
+	s.PaymentServer = val
+}
+
+// 返金.
+// Ref: #/components/schemas/Refund
+type Refund struct {
+	// 返金ID.
+	ID string `json:"id"`
+	// 返金額.
+	Amount int `json:"amount"`
+	// 返金の理由.
+	Reason OptString `json:"reason"`
+	// 返金の状況.
+	Status RefundStatus `json:"status"`
+	// 決済マイクロサービスへのリクエスト回数.
+	Attempts int `json:"attempts"`
+	// 最後に失敗した時のエラー.
+	LastError OptString `json:"last_error"`
+	// 登録日時 (UNIXミリ秒).
+	CreatedAt int64 `json:"created_at"`
+	// 更新日時 (UNIXミリ秒).
+	UpdatedAt int64 `json:"updated_at"`
+}
+
+// GetID returns the value of ID.
+func (s *Refund) GetID() string {
+	return s.ID
+}
+
+// GetAmount returns the value of Amount.
+func (s *Refund) GetAmount() int {
+	return s.Amount
+}
+
+// GetReason returns the value of Reason.
+func (s *Refund) GetReason() OptString {
+	return s.Reason
+}
+
+// GetStatus returns the value of Status.
+func (s *Refund) GetStatus() RefundStatus {
+	return s.Status
+}
+
+// GetAttempts returns the value of Attempts.
+func (s *Refund) GetAttempts() int {
+	return s.Attempts
+}
+
+// GetLastError returns the value of LastError.
+func (s *Refund) GetLastError() OptString {
+	return s.LastError
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *Refund) GetCreatedAt() int64 {
+	return s.CreatedAt
 }
 
-type OwnerGetSalesOKModelsItem struct {
-	// モデル.
-	Model string `json:"model"`
-	// モデルごとの売上.
-	Sales int `json:"sales"`
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *Refund) GetUpdatedAt() int64 {
+	return s.UpdatedAt
 }
 
-// GetModel returns the value of Model.
-func (s *OwnerGetSalesOKModelsItem) GetModel() string {
-	return s.Model
+// SetID sets the value of ID.
+func (s *Refund) SetID(val string) {
+	s.ID = val
 }
 
-// GetSales returns the value of Sales.
-func (s *OwnerGetSalesOKModelsItem) GetSales() int {
-	return s.Sales
+// SetAmount sets the value of Amount.
+func (s *Refund) SetAmount(val int) {
+	s.Amount = val
 }
 
-// SetModel sets the value of Model.
-func (s *OwnerGetSalesOKModelsItem) SetModel(val string) {
-	s.Model = val
+// SetReason sets the value of Reason.
+func (s *Refund) SetReason(val OptString) {
+	s.Reason = val
 }
 
-// SetSales sets the value of Sales.
-func (s *OwnerGetSalesOKModelsItem) SetSales(val int) {
-	s.Sales = val
+// SetStatus sets the value of Status.
+func (s *Refund) SetStatus(val RefundStatus) {
+	s.Status = val
 }
 
-type OwnerPostOwnersCreated struct {
-	// オーナーID.
-	ID string `json:"id"`
-	// 椅子をオーナーに紐づけるための椅子登録用トークン.
-	ChairRegisterToken string `json:"chair_register_token"`
+// SetAttempts sets the value of Attempts.
+func (s *Refund) SetAttempts(val int) {
+	s.Attempts = val
 }
 
-// GetID returns the value of ID.
-func (s *OwnerPostOwnersCreated) GetID() string {
-	return s.ID
+// SetLastError sets the value of LastError.
+func (s *Refund) SetLastError(val OptString) {
+	s.LastError = val
 }
 
-// GetChairRegisterToken returns the value of ChairRegisterToken.
-func (s *OwnerPostOwnersCreated) GetChairRegisterToken() string {
-	return s.ChairRegisterToken
+// SetCreatedAt sets the value of CreatedAt.
+func (s *Refund) SetCreatedAt(val int64) {
+	s.CreatedAt = val
 }
 
-// SetID sets the value of ID.
-func (s *OwnerPostOwnersCreated) SetID(val string) {
-	s.ID = val
+// SetUpdatedAt sets the value of UpdatedAt.
+func (s *Refund) SetUpdatedAt(val int64) {
+	s.UpdatedAt = val
 }
 
-// SetChairRegisterToken sets the value of ChairRegisterToken.
-func (s *OwnerPostOwnersCreated) SetChairRegisterToken(val string) {
-	s.ChairRegisterToken = val
-}
+func (*Refund) ownerPostRideRefundRes() {}
 
-// OwnerPostOwnersCreatedHeaders wraps OwnerPostOwnersCreated with response headers.
-type OwnerPostOwnersCreatedHeaders struct {
-	SetCookie OptString
-	Response  OwnerPostOwnersCreated
+// 返金の状況.
+type RefundStatus string
+
+const (
+	RefundStatusPENDING   RefundStatus = "PENDING"
+	RefundStatusSUCCEEDED RefundStatus = "SUCCEEDED"
+	RefundStatusFAILED    RefundStatus = "FAILED"
+	RefundStatusREJECTED  RefundStatus = "REJECTED"
+)
+
+// AllValues returns all RefundStatus values.
+func (RefundStatus) AllValues() []RefundStatus {
+	return []RefundStatus{
+		RefundStatusPENDING,
+		RefundStatusSUCCEEDED,
+		RefundStatusFAILED,
+		RefundStatusREJECTED,
+	}
 }
 
-// GetSetCookie returns the value of SetCookie.
-func (s *OwnerPostOwnersCreatedHeaders) GetSetCookie() OptString {
-	return s.SetCookie
+// MarshalText implements encoding.TextMarshaler.
+func (s RefundStatus) MarshalText() ([]byte, error) {
+	switch s {
+	case RefundStatusPENDING:
+		return []byte(s), nil
+	case RefundStatusSUCCEEDED:
+		return []byte(s), nil
+	case RefundStatusFAILED:
+		return []byte(s), nil
+	case RefundStatusREJECTED:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
 }
 
-// GetResponse returns the value of Response.
-func (s *OwnerPostOwnersCreatedHeaders) GetResponse() OwnerPostOwnersCreated {
-	return s.Response
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *RefundStatus) UnmarshalText(data []byte) error {
+	switch RefundStatus(data) {
+	case RefundStatusPENDING:
+		*s = RefundStatusPENDING
+		return nil
+	case RefundStatusSUCCEEDED:
+		*s = RefundStatusSUCCEEDED
+		return nil
+	case RefundStatusFAILED:
+		*s = RefundStatusFAILED
+		return nil
+	case RefundStatusREJECTED:
+		*s = RefundStatusREJECTED
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
 }
 
-// SetSetCookie sets the value of SetCookie.
-func (s *OwnerPostOwnersCreatedHeaders) SetSetCookie(val OptString) {
-	s.SetCookie = val
+// ライドの決済.
+// Ref: #/components/schemas/RidePayment
+type RidePayment struct {
+	// 決済額.
+	Amount int `json:"amount"`
+	// 決済の状況
+	// PENDING: 決済マイクロサービスへの送信待ち
+	// SUCCEEDED: 決済済み
+	// FAILED:
+	// リトライし尽くしたが決済できなかった。webappの再起動時に再びリトライされる
+	// REJECTED: 決済マイクロサービスに拒否された.
+	Status RidePaymentStatus `json:"status"`
+	// 決済マイクロサービスへのリクエスト回数.
+	Attempts int `json:"attempts"`
+	// 更新日時 (UNIXミリ秒).
+	UpdatedAt int64 `json:"updated_at"`
 }
 
-// SetResponse sets the value of Response.
-func (s *OwnerPostOwnersCreatedHeaders) SetResponse(val OwnerPostOwnersCreated) {
-	s.Response = val
+// GetAmount returns the value of Amount.
+func (s *RidePayment) GetAmount() int {
+	return s.Amount
 }
 
-func (*OwnerPostOwnersCreatedHeaders) ownerPostOwnersRes() {}
+// GetStatus returns the value of Status.
+func (s *RidePayment) GetStatus() RidePaymentStatus {
+	return s.Status
+}
 
-type OwnerPostOwnersReq struct {
-	// オーナー名.
-	Name string `json:"name"`
+// GetAttempts returns the value of Attempts.
+func (s *RidePayment) GetAttempts() int {
+	return s.Attempts
 }
 
-// GetName returns the value of Name.
-func (s *OwnerPostOwnersReq) GetName() string {
-	return s.Name
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *RidePayment) GetUpdatedAt() int64 {
+	return s.UpdatedAt
 }
 
-// SetName sets the value of Name.
-func (s *OwnerPostOwnersReq) SetName(val string) {
-	s.Name = val
+// SetAmount sets the value of Amount.
+func (s *RidePayment) SetAmount(val int) {
+	s.Amount = val
 }
 
-type PostInitializeOK struct {
-	// 実装言語
-	// - go
-	// - perl
-	// - php
-	// - python
-	// - ruby
-	// - rust
-	// - node.
-	Language string `json:"language"`
+// SetStatus sets the value of Status.
+func (s *RidePayment) SetStatus(val RidePaymentStatus) {
+	s.Status = val
 }
 
-// GetLanguage returns the value of Language.
-func (s *PostInitializeOK) GetLanguage() string {
-	return s.Language
+// SetAttempts sets the value of Attempts.
+func (s *RidePayment) SetAttempts(val int) {
+	s.Attempts = val
 }
 
-// SetLanguage sets the value of Language.
-func (s *PostInitializeOK) SetLanguage(val string) {
-	s.Language = val
+// SetUpdatedAt sets the value of UpdatedAt.
+func (s *RidePayment) SetUpdatedAt(val int64) {
+	s.UpdatedAt = val
 }
 
-type PostInitializeReq struct {
-	// 決済サーバーアドレス.
-	PaymentServer string `json:"payment_server"`
+// 決済の状況
+// PENDING: 決済マイクロサービスへの送信待ち
+// SUCCEEDED: 決済済み
+// FAILED:
+// リトライし尽くしたが決済できなかった。webappの再起動時に再びリトライされる
+// REJECTED: 決済マイクロサービスに拒否された.
+type RidePaymentStatus string
+
+const (
+	RidePaymentStatusPENDING   RidePaymentStatus = "PENDING"
+	RidePaymentStatusSUCCEEDED RidePaymentStatus = "SUCCEEDED"
+	RidePaymentStatusFAILED    RidePaymentStatus = "FAILED"
+	RidePaymentStatusREJECTED  RidePaymentStatus = "REJECTED"
+)
+
+// AllValues returns all RidePaymentStatus values.
+func (RidePaymentStatus) AllValues() []RidePaymentStatus {
+	return []RidePaymentStatus{
+		RidePaymentStatusPENDING,
+		RidePaymentStatusSUCCEEDED,
+		RidePaymentStatusFAILED,
+		RidePaymentStatusREJECTED,
+	}
 }
 
-// GetPaymentServer returns the value of PaymentServer.
-func (s *PostInitializeReq) GetPaymentServer() string {
-	return s.PaymentServer
+// MarshalText implements encoding.TextMarshaler.
+func (s RidePaymentStatus) MarshalText() ([]byte, error) {
+	switch s {
+	case RidePaymentStatusPENDING:
+		return []byte(s), nil
+	case RidePaymentStatusSUCCEEDED:
+		return []byte(s), nil
+	case RidePaymentStatusFAILED:
+		return []byte(s), nil
+	case RidePaymentStatusREJECTED:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
 }
 
-// SetPaymentServer sets the value of PaymentServer.
-func (s *PostInitializeReq) SetPaymentServer(val string) {
-	s.PaymentServer = val
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *RidePaymentStatus) UnmarshalText(data []byte) error {
+	switch RidePaymentStatus(data) {
+	case RidePaymentStatusPENDING:
+		*s = RidePaymentStatusPENDING
+		return nil
+	case RidePaymentStatusSUCCEEDED:
+		*s = RidePaymentStatusSUCCEEDED
+		return nil
+	case RidePaymentStatusFAILED:
+		*s = RidePaymentStatusFAILED
+		return nil
+	case RidePaymentStatusREJECTED:
+		*s = RidePaymentStatusREJECTED
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
 }
 
 // ライドのステータス
-// MATCHING:
+// - SCHEDULED: 配車日時を予約していて、まだマッチング処理を始めていない
+// - MATCHING:
 // サービス上でマッチング処理を行なっていて椅子が確定していない
-// ENROUTE: 椅子が確定し、乗車位置に向かっている
-// PICKUP: 椅子が乗車位置に到着して、ユーザーの乗車を待機している
-// CARRYING: ユーザーが乗車し、椅子が目的地に向かっている
-// ARRIVED: 目的地に到着した
-// COMPLETED: ユーザーの決済・椅子評価が完了した.
+// - ENROUTE: 椅子が確定し、乗車位置に向かっている
+// - PICKUP: 椅子が乗車位置に到着して、ユーザーの乗車を待機している
+// - CARRYING: ユーザーが乗車し、椅子が目的地に向かっている
+// - WAYPOINT:
+// 椅子が経由地に到着し、次の経由地もしくは目的地に向かっている
+// - ARRIVED: 目的地に到着した
+// - COMPLETED: ユーザーの決済・椅子評価が完了した
+// - CANCELED: 乗車前にユーザーがライドをキャンセルした.
 // Ref: #/components/schemas/RideStatus
 type RideStatus string
 
 const (
+	RideStatusSCHEDULED RideStatus = "SCHEDULED"
 	RideStatusMATCHING  RideStatus = "MATCHING"
 	RideStatusENROUTE   RideStatus = "ENROUTE"
 	RideStatusPICKUP    RideStatus = "PICKUP"
 	RideStatusCARRYING  RideStatus = "CARRYING"
+	RideStatusWAYPOINT  RideStatus = "WAYPOINT"
 	RideStatusARRIVED   RideStatus = "ARRIVED"
 	RideStatusCOMPLETED RideStatus = "COMPLETED"
+	RideStatusCANCELED  RideStatus = "CANCELED"
 )
 
 // AllValues returns all RideStatus values.
 func (RideStatus) AllValues() []RideStatus {
 	return []RideStatus{
+		RideStatusSCHEDULED,
 		RideStatusMATCHING,
 		RideStatusENROUTE,
 		RideStatusPICKUP,
 		RideStatusCARRYING,
+		RideStatusWAYPOINT,
 		RideStatusARRIVED,
 		RideStatusCOMPLETED,
+		RideStatusCANCELED,
 	}
 }
 
 // MarshalText implements encoding.TextMarshaler.
 func (s RideStatus) MarshalText() ([]byte, error) {
 	switch s {
+	case RideStatusSCHEDULED:
+		return []byte(s), nil
 	case RideStatusMATCHING:
 		return []byte(s), nil
 	case RideStatusENROUTE:
@@ -2038,10 +5001,14 @@ func (s RideStatus) MarshalText() ([]byte, error) {
 		return []byte(s), nil
 	case RideStatusCARRYING:
 		return []byte(s), nil
+	case RideStatusWAYPOINT:
+		return []byte(s), nil
 	case RideStatusARRIVED:
 		return []byte(s), nil
 	case RideStatusCOMPLETED:
 		return []byte(s), nil
+	case RideStatusCANCELED:
+		return []byte(s), nil
 	default:
 		return nil, errors.Errorf("invalid value: %q", s)
 	}
@@ -2050,6 +5017,9 @@ func (s RideStatus) MarshalText() ([]byte, error) {
 // UnmarshalText implements encoding.TextUnmarshaler.
 func (s *RideStatus) UnmarshalText(data []byte) error {
 	switch RideStatus(data) {
+	case RideStatusSCHEDULED:
+		*s = RideStatusSCHEDULED
+		return nil
 	case RideStatusMATCHING:
 		*s = RideStatusMATCHING
 		return nil
@@ -2062,17 +5032,134 @@ func (s *RideStatus) UnmarshalText(data []byte) error {
 	case RideStatusCARRYING:
 		*s = RideStatusCARRYING
 		return nil
+	case RideStatusWAYPOINT:
+		*s = RideStatusWAYPOINT
+		return nil
 	case RideStatusARRIVED:
 		*s = RideStatusARRIVED
 		return nil
 	case RideStatusCOMPLETED:
 		*s = RideStatusCOMPLETED
 		return nil
+	case RideStatusCANCELED:
+		*s = RideStatusCANCELED
+		return nil
 	default:
 		return errors.Errorf("invalid value: %q", data)
 	}
 }
 
+// ライドの経由地.
+// Ref: #/components/schemas/RideStop
+type RideStop struct {
+	Coordinate Coordinate `json:"coordinate"`
+	// 経由地に到着した日時 (UNIXミリ秒)。まだ到着していなければ含まれない.
+	ArrivedAt OptInt64 `json:"arrived_at"`
+}
+
+// GetCoordinate returns the value of Coordinate.
+func (s *RideStop) GetCoordinate() Coordinate {
+	return s.Coordinate
+}
+
+// GetArrivedAt returns the value of ArrivedAt.
+func (s *RideStop) GetArrivedAt() OptInt64 {
+	return s.ArrivedAt
+}
+
+// SetCoordinate sets the value of Coordinate.
+func (s *RideStop) SetCoordinate(val Coordinate) {
+	s.Coordinate = val
+}
+
+// SetArrivedAt sets the value of ArrivedAt.
+func (s *RideStop) SetArrivedAt(val OptInt64) {
+	s.ArrivedAt = val
+}
+
+// 集計単位ごとの売上.
+// Ref: #/components/schemas/SalesPoint
+type SalesPoint struct {
+	// 集計単位の開始日時 (UNIXミリ秒).
+	Start int64 `json:"start"`
+	// 売上。返金が売上を上回ると負の値になる.
+	Sales int `json:"sales"`
+	// 完了したライドの数.
+	Rides int `json:"rides"`
+	// 完了したライドの評価の平均。完了したライドが無ければ含まれない.
+	AverageEvaluation OptFloat64 `json:"average_evaluation"`
+}
+
+// GetStart returns the value of Start.
+func (s *SalesPoint) GetStart() int64 {
+	return s.Start
+}
+
+// GetSales returns the value of Sales.
+func (s *SalesPoint) GetSales() int {
+	return s.Sales
+}
+
+// GetRides returns the value of Rides.
+func (s *SalesPoint) GetRides() int {
+	return s.Rides
+}
+
+// GetAverageEvaluation returns the value of AverageEvaluation.
+func (s *SalesPoint) GetAverageEvaluation() OptFloat64 {
+	return s.AverageEvaluation
+}
+
+// SetStart sets the value of Start.
+func (s *SalesPoint) SetStart(val int64) {
+	s.Start = val
+}
+
+// SetSales sets the value of Sales.
+func (s *SalesPoint) SetSales(val int) {
+	s.Sales = val
+}
+
+// SetRides sets the value of Rides.
+func (s *SalesPoint) SetRides(val int) {
+	s.Rides = val
+}
+
+// SetAverageEvaluation sets the value of AverageEvaluation.
+func (s *SalesPoint) SetAverageEvaluation(val OptFloat64) {
+	s.AverageEvaluation = val
+}
+
+type SurgeRate int
+
+// 椅子が記録した位置情報.
+// Ref: #/components/schemas/TrackPoint
+type TrackPoint struct {
+	Coordinate Coordinate `json:"coordinate"`
+	// 記録日時 (UNIXミリ秒).
+	RecordedAt int64 `json:"recorded_at"`
+}
+
+// GetCoordinate returns the value of Coordinate.
+func (s *TrackPoint) GetCoordinate() Coordinate {
+	return s.Coordinate
+}
+
+// GetRecordedAt returns the value of RecordedAt.
+func (s *TrackPoint) GetRecordedAt() int64 {
+	return s.RecordedAt
+}
+
+// SetCoordinate sets the value of Coordinate.
+func (s *TrackPoint) SetCoordinate(val Coordinate) {
+	s.Coordinate = val
+}
+
+// SetRecordedAt sets the value of RecordedAt.
+func (s *TrackPoint) SetRecordedAt(val int64) {
+	s.RecordedAt = val
+}
+
 // 簡易ユーザー情報.
 // Ref: #/components/schemas/User
 type User struct {
@@ -2080,6 +5167,8 @@ type User struct {
 	ID string `json:"id"`
 	// ユーザー名.
 	Name string `json:"name"`
+	// 椅子によるユーザーの評価。椅子への通知にのみ含まれる.
+	Stats OptUserStats `json:"stats"`
 }
 
 // GetID returns the value of ID.
@@ -2092,6 +5181,11 @@ func (s *User) GetName() string {
 	return s.Name
 }
 
+// GetStats returns the value of Stats.
+func (s *User) GetStats() OptUserStats {
+	return s.Stats
+}
+
 // SetID sets the value of ID.
 func (s *User) SetID(val string) {
 	s.ID = val
@@ -2102,7 +5196,12 @@ func (s *User) SetName(val string) {
 	s.Name = val
 }
 
-// ユーザー向け通知データ.
+// SetStats sets the value of Stats.
+func (s *User) SetStats(val OptUserStats) {
+	s.Stats = val
+}
+
+// ユーザー向け通知データ。pickup_coordinateは配車位置、destination_coordinateは目的地.
 // Ref: #/components/schemas/UserNotificationData
 type UserNotificationData struct {
 	// ライドID.
@@ -2114,9 +5213,15 @@ type UserNotificationData struct {
 	Status RideStatus `json:"status"`
 	// 椅子情報.
 	Chair OptUserNotificationDataChair `json:"chair"`
-	// 配車要求日時.
+	// 経由地。経由地が無ければ含まれない.
+	Stops []RideStop `json:"stops"`
+	// 相乗りの状況。相乗りを希望したライドでなければ含まれない.
+	Pool OptUserNotificationDataPool `json:"pool"`
+	// 予約した配車日時 (UNIXミリ秒)。予約でなければ含まれない.
+	ScheduledAt OptInt64 `json:"scheduled_at"`
+	// 配車要求日時 (UNIXミリ秒).
 	CreatedAt int64 `json:"created_at"`
-	// 配車要求更新日時.
+	// 配車要求更新日時 (UNIXミリ秒).
 	UpdatedAt int64 `json:"updated_at"`
 }
 
@@ -2150,6 +5255,21 @@ func (s *UserNotificationData) GetChair() OptUserNotificationDataChair {
 	return s.Chair
 }
 
+// GetStops returns the value of Stops.
+func (s *UserNotificationData) GetStops() []RideStop {
+	return s.Stops
+}
+
+// GetPool returns the value of Pool.
+func (s *UserNotificationData) GetPool() OptUserNotificationDataPool {
+	return s.Pool
+}
+
+// GetScheduledAt returns the value of ScheduledAt.
+func (s *UserNotificationData) GetScheduledAt() OptInt64 {
+	return s.ScheduledAt
+}
+
 // GetCreatedAt returns the value of CreatedAt.
 func (s *UserNotificationData) GetCreatedAt() int64 {
 	return s.CreatedAt
@@ -2190,6 +5310,21 @@ func (s *UserNotificationData) SetChair(val OptUserNotificationDataChair) {
 	s.Chair = val
 }
 
+// SetStops sets the value of Stops.
+func (s *UserNotificationData) SetStops(val []RideStop) {
+	s.Stops = val
+}
+
+// SetPool sets the value of Pool.
+func (s *UserNotificationData) SetPool(val OptUserNotificationDataPool) {
+	s.Pool = val
+}
+
+// SetScheduledAt sets the value of ScheduledAt.
+func (s *UserNotificationData) SetScheduledAt(val OptInt64) {
+	s.ScheduledAt = val
+}
+
 // SetCreatedAt sets the value of CreatedAt.
 func (s *UserNotificationData) SetCreatedAt(val int64) {
 	s.CreatedAt = val
@@ -2254,10 +5389,10 @@ func (s *UserNotificationDataChair) SetStats(val UserNotificationDataChairStats)
 
 // 椅子の統計情報.
 type UserNotificationDataChairStats struct {
-	// 総乗車回数.
+	// 完了した乗車の回数の合計.
 	TotalRidesCount int `json:"total_rides_count"`
 	// 総評価平均.
-	TotalEvaluationAvg float64 `json:"total_evaluation_avg"`
+	TotalEvaluationAvg OptFloat64 `json:"total_evaluation_avg"`
 }
 
 // GetTotalRidesCount returns the value of TotalRidesCount.
@@ -2266,7 +5401,7 @@ func (s *UserNotificationDataChairStats) GetTotalRidesCount() int {
 }
 
 // GetTotalEvaluationAvg returns the value of TotalEvaluationAvg.
-func (s *UserNotificationDataChairStats) GetTotalEvaluationAvg() float64 {
+func (s *UserNotificationDataChairStats) GetTotalEvaluationAvg() OptFloat64 {
 	return s.TotalEvaluationAvg
 }
 
@@ -2276,6 +5411,50 @@ func (s *UserNotificationDataChairStats) SetTotalRidesCount(val int) {
 }
 
 // SetTotalEvaluationAvg sets the value of TotalEvaluationAvg.
-func (s *UserNotificationDataChairStats) SetTotalEvaluationAvg(val float64) {
+func (s *UserNotificationDataChairStats) SetTotalEvaluationAvg(val OptFloat64) {
+	s.TotalEvaluationAvg = val
+}
+
+// 相乗りの状況。相乗りを希望したライドでなければ含まれない.
+type UserNotificationDataPool struct {
+	// 同じ椅子に相乗りしている(これから乗る)他のユーザーの数.
+	CoRiders int `json:"co_riders"`
+}
+
+// GetCoRiders returns the value of CoRiders.
+func (s *UserNotificationDataPool) GetCoRiders() int {
+	return s.CoRiders
+}
+
+// SetCoRiders sets the value of CoRiders.
+func (s *UserNotificationDataPool) SetCoRiders(val int) {
+	s.CoRiders = val
+}
+
+// 椅子によるユーザーの評価。椅子への通知にのみ含まれる.
+type UserStats struct {
+	// 完了した乗車の回数の合計.
+	TotalRidesCount int `json:"total_rides_count"`
+	// 椅子による評価の平均。評価されていなければ0.
+	TotalEvaluationAvg float64 `json:"total_evaluation_avg"`
+}
+
+// GetTotalRidesCount returns the value of TotalRidesCount.
+func (s *UserStats) GetTotalRidesCount() int {
+	return s.TotalRidesCount
+}
+
+// GetTotalEvaluationAvg returns the value of TotalEvaluationAvg.
+func (s *UserStats) GetTotalEvaluationAvg() float64 {
+	return s.TotalEvaluationAvg
+}
+
+// SetTotalRidesCount sets the value of TotalRidesCount.
+func (s *UserStats) SetTotalRidesCount(val int) {
+	s.TotalRidesCount = val
+}
+
+// SetTotalEvaluationAvg sets the value of TotalEvaluationAvg.
+func (s *UserStats) SetTotalEvaluationAvg(val float64) {
 	s.TotalEvaluationAvg = val
 }
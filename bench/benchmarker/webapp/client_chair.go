@@ -139,6 +139,35 @@ func (c *Client) ChairPostRideStatus(ctx context.Context, rideID string, reqBody
 	return resBody, nil
 }
 
+// ChairPostRideEvaluation 椅子が完了したライドのユーザーを評価する
+func (c *Client) ChairPostRideEvaluation(ctx context.Context, rideID string, reqBody *api.ChairPostRideEvaluationReq) (*api.ChairPostRideEvaluationNoContent, error) {
+	reqBodyBuf, err := json.Marshal(reqBody)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := c.agent.NewRequest(http.MethodPost, fmt.Sprintf("/api/chair/rides/%s/evaluation", rideID), bytes.NewReader(reqBodyBuf))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, modifier := range c.requestModifiers {
+		modifier(req)
+	}
+
+	resp, err := c.agent.Do(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("POST /api/chair/rides/{rideID}/evaluationのリクエストが失敗しました: %w", err)
+	}
+	defer closeBody(resp)
+
+	if resp.StatusCode != http.StatusNoContent {
+		return nil, fmt.Errorf("POST /api/chair/rides/{rideID}/evaluationへのリクエストに対して、期待されたHTTPステータスコードが確認できませんでした (expected:%d, actual:%d)", http.StatusNoContent, resp.StatusCode)
+	}
+
+	return &api.ChairPostRideEvaluationNoContent{}, nil
+}
+
 type ChairGetNotificationOK struct {
 	Data         null.Value[ChairNotificationData] `json:"data"`
 	RetryAfterMs null.Int                          `json:"retry_after_ms"`
@@ -150,6 +179,8 @@ type ChairNotificationData struct {
 	PickupCoordinate      api.Coordinate `json:"pickup_coordinate"`
 	DestinationCoordinate api.Coordinate `json:"destination_coordinate"`
 	Status                api.RideStatus `json:"status"`
+	// Stops 経由地。経由地が無ければ含まれない
+	Stops []api.RideStop `json:"stops"`
 }
 
 func (c *Client) ChairGetNotification(ctx context.Context) iter.Seq2[*ChairGetNotificationOK, error] {
@@ -162,6 +193,9 @@ func (c *Client) chairGetNotification(ctx context.Context, nested bool) iter.Seq
 		return func(yield func(*ChairGetNotificationOK, error) bool) { yield(nil, err) }
 	}
 
+	// 対応していればServer-Sent Eventsで、そうでなければpollingで通知を受け取る
+	req.Header.Set("Accept", "text/event-stream")
+
 	for _, modifier := range c.requestModifiers {
 		modifier(req)
 	}
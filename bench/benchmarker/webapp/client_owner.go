@@ -9,6 +9,7 @@ import (
 	"net/url"
 	"strconv"
 
+	"github.com/guregu/null/v5"
 	"github.com/isucon/isucon14/bench/benchmarker/webapp/api"
 )
 
@@ -81,7 +82,23 @@ func (c *Client) OwnerGetSales(ctx context.Context, params *api.OwnerGetSalesPar
 	return resBody, nil
 }
 
-func (c *Client) OwnerGetChairs(ctx context.Context) (*api.OwnerGetChairsOK, error) {
+type OwnerGetChairsOK struct {
+	Chairs []OwnerGetChairsOKChairsItem `json:"chairs"`
+}
+
+type OwnerGetChairsOKChairsItem struct {
+	ID                     string   `json:"id"`
+	Name                   string   `json:"name"`
+	Model                  string   `json:"model"`
+	Active                 bool     `json:"active"`
+	RegisteredAt           int64    `json:"registered_at"`
+	TotalDistance          int      `json:"total_distance"`
+	TotalDistanceUpdatedAt null.Int `json:"total_distance_updated_at"`
+	// RetiredAt 引退日時 (UNIXミリ秒)。引退していなければ含まれない
+	RetiredAt null.Int `json:"retired_at"`
+}
+
+func (c *Client) OwnerGetChairs(ctx context.Context) (*OwnerGetChairsOK, error) {
 	req, err := c.agent.NewRequest(http.MethodGet, "/api/owner/chairs", nil)
 	if err != nil {
 		return nil, err
@@ -101,7 +118,7 @@ func (c *Client) OwnerGetChairs(ctx context.Context) (*api.OwnerGetChairsOK, err
 		return nil, fmt.Errorf("GET /api/owner/chairsへのリクエストに対して、期待されたHTTPステータスコードが確認できませんでした (expected:%d, actual:%d)", http.StatusOK, resp.StatusCode)
 	}
 
-	resBody := &api.OwnerGetChairsOK{}
+	resBody := &OwnerGetChairsOK{}
 	if err := json.NewDecoder(resp.Body).Decode(resBody); err != nil {
 		return nil, fmt.Errorf("GET /api/owner/chairsのJSONのdecodeに失敗しました: %w", err)
 	}
@@ -78,8 +78,21 @@ func (c *Client) AppGetRequests(ctx context.Context) (*api.AppGetRidesOK, error)
 	return resBody, nil
 }
 
-func (c *Client) AppPostRidesEstimatedFare(ctx context.Context, reqBody *api.AppPostRidesEstimatedFareReq) (*api.AppPostRidesEstimatedFareOK, error) {
-	reqBodyBuf, err := reqBody.MarshalJSON()
+type AppPostRidesEstimatedFareReq struct {
+	PickupCoordinate      api.Coordinate `json:"pickup_coordinate"`
+	DestinationCoordinate api.Coordinate `json:"destination_coordinate"`
+	// Stops 配車位置から目的地までに順に経由する位置
+	Stops []api.Coordinate `json:"stops,omitempty"`
+}
+
+type AppPostRidesEstimatedFareOK struct {
+	Fare      int `json:"fare"`
+	Discount  int `json:"discount"`
+	SurgeRate int `json:"surge_rate"`
+}
+
+func (c *Client) AppPostRidesEstimatedFare(ctx context.Context, reqBody *AppPostRidesEstimatedFareReq) (*AppPostRidesEstimatedFareOK, error) {
+	reqBodyBuf, err := json.Marshal(reqBody)
 	if err != nil {
 		return nil, err
 	}
@@ -103,7 +116,7 @@ func (c *Client) AppPostRidesEstimatedFare(ctx context.Context, reqBody *api.App
 		return nil, fmt.Errorf("POST /app/rides/estimated-fareへのリクエストに対して、期待されたHTTPステータスコードが確認できませんでした (expected:%d, actual:%d)", http.StatusOK, resp.StatusCode)
 	}
 
-	resBody := &api.AppPostRidesEstimatedFareOK{}
+	resBody := &AppPostRidesEstimatedFareOK{}
 	if err := json.NewDecoder(resp.Body).Decode(resBody); err != nil {
 		return nil, fmt.Errorf("POST /app/rides/estimated-fareのJSONのdecodeに失敗しました: %w", err)
 	}
@@ -111,8 +124,23 @@ func (c *Client) AppPostRidesEstimatedFare(ctx context.Context, reqBody *api.App
 	return resBody, nil
 }
 
-func (c *Client) AppPostRequest(ctx context.Context, reqBody *api.AppPostRidesReq) (*api.AppPostRidesAccepted, error) {
-	reqBodyBuf, err := reqBody.MarshalJSON()
+type AppPostRidesReq struct {
+	PickupCoordinate      api.Coordinate `json:"pickup_coordinate"`
+	DestinationCoordinate api.Coordinate `json:"destination_coordinate"`
+	// Stops 配車位置から目的地までに順に経由する位置
+	Stops []api.Coordinate `json:"stops,omitempty"`
+	// ScheduledAt 予約する配車日時 (UNIXミリ秒)
+	ScheduledAt *int64 `json:"scheduled_at,omitempty"`
+}
+
+type AppPostRidesAccepted struct {
+	RideID    string `json:"ride_id"`
+	Fare      int    `json:"fare"`
+	SurgeRate int    `json:"surge_rate"`
+}
+
+func (c *Client) AppPostRequest(ctx context.Context, reqBody *AppPostRidesReq) (*AppPostRidesAccepted, error) {
+	reqBodyBuf, err := json.Marshal(reqBody)
 	if err != nil {
 		return nil, err
 	}
@@ -136,7 +164,7 @@ func (c *Client) AppPostRequest(ctx context.Context, reqBody *api.AppPostRidesRe
 		return nil, fmt.Errorf("POST /api/app/ridesへのリクエストに対して、期待されたHTTPステータスコードが確認できませんでした (expected:%d, actual:%d)", http.StatusAccepted, resp.StatusCode)
 	}
 
-	resBody := &api.AppPostRidesAccepted{}
+	resBody := &AppPostRidesAccepted{}
 	if err := json.NewDecoder(resp.Body).Decode(resBody); err != nil {
 		return nil, fmt.Errorf("POST /api/app/ridesのJSONのdecodeに失敗しました: %w", err)
 	}
@@ -177,6 +205,34 @@ func (c *Client) AppPostRequestEvaluate(ctx context.Context, rideID string, reqB
 	return resBody, nil
 }
 
+func (c *Client) AppPostRideCancel(ctx context.Context, rideID string) (*api.AppPostRideCancelOK, error) {
+	req, err := c.agent.NewRequest(http.MethodPost, fmt.Sprintf("/api/app/rides/%s/cancel", rideID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, modifier := range c.requestModifiers {
+		modifier(req)
+	}
+
+	resp, err := c.agent.Do(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("POST /api/app/rides/{ride_id}/cancelのリクエストが失敗しました: %w", err)
+	}
+	defer closeBody(resp)
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("POST /api/app/rides/{ride_id}/cancelへのリクエストに対して、期待されたHTTPステータスコードが確認できませんでした (expected:%d, actual:%d)", http.StatusOK, resp.StatusCode)
+	}
+
+	resBody := &api.AppPostRideCancelOK{}
+	if err := json.NewDecoder(resp.Body).Decode(resBody); err != nil {
+		return nil, fmt.Errorf("POST /api/app/rides/{ride_id}/cancelのJSONのdecodeに失敗しました: %w", err)
+	}
+
+	return resBody, nil
+}
+
 func (c *Client) AppPostPaymentMethods(ctx context.Context, reqBody *api.AppPostPaymentMethodsReq) (*api.AppPostPaymentMethodsNoContent, error) {
 	reqBodyBuf, err := reqBody.MarshalJSON()
 	if err != nil {
@@ -220,6 +276,8 @@ type UserNotificationData struct {
 	Chair                 api.OptUserNotificationDataChair `json:"chair"`
 	CreatedAt             int64                            `json:"created_at"`
 	UpdatedAt             int64                            `json:"updated_at"`
+	// Stops 経由地。経由地が無ければ含まれない
+	Stops []api.RideStop `json:"stops"`
 }
 
 func (c *Client) AppGetNotification(ctx context.Context) iter.Seq2[*AppGetNotificationOK, error] {
@@ -232,6 +290,9 @@ func (c *Client) appGetNotification(ctx context.Context, nested bool) iter.Seq2[
 		return func(yield func(*AppGetNotificationOK, error) bool) { yield(nil, err) }
 	}
 
+	// 対応していればServer-Sent Eventsで、そうでなければpollingで通知を受け取る
+	req.Header.Set("Accept", "text/event-stream")
+
 	for _, modifier := range c.requestModifiers {
 		modifier(req)
 	}
@@ -18,6 +18,9 @@ const (
 	ChairStateActive
 )
 
+// DenyRequestProbability 椅子がマッチした配椅子要求を拒否する確率
+const DenyRequestProbability = 0.05
+
 type ChairID int
 
 type Chair struct {
@@ -41,16 +44,22 @@ type Chair struct {
 	RegisteredData RegisteredChairData
 	// matchingData マッチング通知情報
 	matchingData *ChairNotificationEventMatched
+	// deniedServerRequestID 最後に拒否したリクエストのサーバー上でのID
+	deniedServerRequestID string
 	// Request 進行中のリクエスト
 	Request *Request
 	// RequestHistory 引き受けたリクエストの履歴
 	RequestHistory *concurrent.SimpleSlice[*Request]
+	// unevaluatedRequest 完了したが、まだ客を評価していないリクエスト
+	unevaluatedRequest *Request
 	// Client webappへのクライアント
 	Client ChairClient
 	// Rand 専用の乱数
 	Rand *rand.Rand
 	// ActivatedAt Active化レスポンスが返ってきた日時
 	ActivatedAt time.Time
+	// surgeState 空いているかどうかが最後に変わりえた日時
+	surgeState surgeState
 	// tickDone 行動が完了しているかどうか
 	tickDone tickDone
 	// notificationConn 通知ストリームコネクション
@@ -108,14 +117,37 @@ func (c *Chair) Tick(ctx *Context) error {
 		}
 	}
 
+	// 完了したリクエストの客を評価する。失敗しても評価し直さない
+	if req := c.unevaluatedRequest; req != nil {
+		c.unevaluatedRequest = nil
+		if err := c.Client.SendRiderEvaluation(ctx, req, c.evaluateRider(req.User)); err != nil {
+			return WrapCodeError(ErrorCodeFailedToEvaluateRider, err)
+		}
+	}
+
 	switch {
 	// 進行中のリクエストが存在
 	case c.Request != nil:
 		switch c.Request.Statuses.Chair {
 		case RequestStatusMatching:
-			// Active状態なら配車要求をACKする
+			// Active状態なら配車要求をACKする。ただしライドの拒否を検証する場合は一定の確率で拒否する
 			// そうでないなら、応答せずにハングさせる
-			if c.State == ChairStateActive {
+			if c.State == ChairStateActive && c.World.Features.RejectRides && c.Rand.Float64() < DenyRequestProbability {
+				c.surgeState.touch()
+				c.Request.surgeState.touch()
+				err := c.Client.SendDenyRequest(ctx, c, c.Request)
+				c.surgeState.touch()
+				c.Request.surgeState.touch()
+				if err != nil {
+					return WrapCodeError(ErrorCodeFailedToDenyRequest, err)
+				}
+
+				// 拒否したリクエストが再び割り当てられることはないので手放す
+				c.deniedServerRequestID = c.Request.ServerID
+				c.Request = nil
+				c.matchingData = nil
+				c.World.EmptyChairs.Add(c)
+			} else if c.State == ChairStateActive {
 				c.Request.Statuses.Lock()
 
 				c.Request.BenchRequestAcceptTime = time.Now()
@@ -212,13 +244,21 @@ func (c *Chair) Tick(ctx *Context) error {
 					})
 				}
 			} else {
-				// 目的地に向かう
+				// 次の経由地もしくは目的地に向かう
 				c.Location.MoveTo(&LocationEntry{
-					Coord: c.moveToward(c.Request.DestinationPoint),
+					Coord: c.moveToward(c.Request.NextTarget()),
 					Time:  time,
 				})
 			}
 
+			if c.Request.ArrivedStops < len(c.Request.Stops) {
+				if c.Location.Current().Equals(c.Request.NextTarget()) {
+					// 経由地に到着したので次の経由地もしくは目的地に向かう
+					c.Request.ArrivedStops++
+				}
+				break
+			}
+
 			if c.Location.Current().Equals(c.Request.DestinationPoint) {
 				// 目的地に到着
 				c.Request.Statuses.Desired = RequestStatusArrived
@@ -256,11 +296,13 @@ func (c *Chair) Tick(ctx *Context) error {
 
 		// 椅子がリクエストを正常に認識する
 		c.Request = req
+		req.surgeState.touch()
 		// 10%の確率で迂回させる(最短距離より1単位速度分だけ遠回しさせる)
 		c.detour = c.Rand.Float64() < 0.1
 		c.detoured = false
 		if c.detour {
-			if c.Rand.IntN(2) == 0 {
+			// 経由地があるリクエストは乗車後には迂回しない
+			if c.Rand.IntN(2) == 0 || len(c.Request.Stops) > 0 {
 				c.detourIn = RequestStatusDispatching
 				c.detourPoint = CalculateRandomDetourPoint(c.Location.Current(), c.Request.PickupPoint, c.Model.Speed, c.Rand)
 			} else {
@@ -290,7 +332,9 @@ func (c *Chair) Tick(ctx *Context) error {
 			c.notificationConn = conn
 		}
 
+		c.surgeState.touch()
 		err := c.Client.SendActivate(ctx, c)
+		c.surgeState.touch()
 		if err != nil {
 			return WrapCodeError(ErrorCodeFailedToActivate, err)
 		}
@@ -416,13 +460,24 @@ func (c *Chair) moveRandom() (to Coordinate) {
 
 func (c *Chair) HandleNotification(event NotificationEvent) error {
 	switch data := event.(type) {
+	case *ChairNotificationEventScheduled:
+		return WrapCodeError(ErrorCodeUnexpectedChairRequestStatusTransitionOccurred, fmt.Errorf("マッチングを待っている予約したライドが椅子に通知されました (chair_id: %s, ride_id: %s)", c.ServerID, data.ServerRequestID))
+
 	case *ChairNotificationEventMatched:
+		if data.ServerRequestID == c.deniedServerRequestID {
+			// 拒否する前に送られていた通知なので無視する
+			return nil
+		}
 		if c.matchingData != nil && c.matchingData.ServerRequestID != data.ServerRequestID {
 			// 椅子が別のリクエストを保持している
 			return WrapCodeError(ErrorCodeChairAlreadyHasRequest, fmt.Errorf("chair_id: %s, current_ride_id: %s, got: %s", c.ServerID, c.matchingData.ServerRequestID, data.ServerRequestID))
 		}
 		c.World.EmptyChairs.Delete(c)
 		c.matchingData = data
+		c.surgeState.touch()
+		if req := c.World.RequestDB.GetByServerID(data.ServerRequestID); req != nil {
+			req.surgeState.touch()
+		}
 
 	case *ChairNotificationEventDispatching:
 		if err := c.ValidateChairNotificationEvent(data.ServerRequestID, data.ChairNotificationEvent); err != nil {
@@ -439,6 +494,17 @@ func (c *Chair) HandleNotification(event NotificationEvent) error {
 			return WrapCodeError(ErrorCodeChairReceivedDataIsWrong, err)
 		}
 
+	case *ChairNotificationEventWaypoint:
+		if err := c.ValidateChairNotificationEvent(data.ServerRequestID, data.ChairNotificationEvent); err != nil {
+			return WrapCodeError(ErrorCodeChairReceivedDataIsWrong, err)
+		}
+		if c.Request == nil || c.Request.ServerID != data.ServerRequestID {
+			return WrapCodeError(ErrorCodeChairNotAssignedButStatusChanged, fmt.Errorf("chair_id: %s, ride_id: %s, got: WAYPOINT", c.ServerID, data.ServerRequestID))
+		}
+		if err := c.Request.ValidateWaypoint(data.Stops, data.ArrivedStops); err != nil {
+			return WrapCodeError(ErrorCodeChairReceivedDataIsWrong, fmt.Errorf("ride_id: %s, %w", data.ServerRequestID, err))
+		}
+
 	case *ChairNotificationEventArrived:
 		if err := c.ValidateChairNotificationEvent(data.ServerRequestID, data.ChairNotificationEvent); err != nil {
 			return WrapCodeError(ErrorCodeChairReceivedDataIsWrong, err)
@@ -467,15 +533,24 @@ func (c *Chair) HandleNotification(event NotificationEvent) error {
 		}
 
 		request.Statuses.Chair = RequestStatusCompleted
+		if c.World.Features.RiderEvaluation {
+			c.unevaluatedRequest = request
+		}
 
 		// 進行中のリクエストが無い状態にする
 		c.Request = nil
 		c.matchingData = nil
 		c.World.EmptyChairs.Add(c)
+		c.surgeState.touch()
 	}
 	return nil
 }
 
+// evaluateRider 客のマナーに多少のばらつきを加えて評価する
+func (c *Chair) evaluateRider(user *User) int {
+	return max(1, min(5, user.Manner+c.Rand.IntN(3)-1))
+}
+
 func (c *Chair) ValidateChairNotificationEvent(rideID string, event ChairNotificationEvent) error {
 	if c.matchingData == nil {
 		return fmt.Errorf("進行中のライドがないときに進行中状態の通知が届きました (ride_id: %s)", rideID)
@@ -24,6 +24,8 @@ type LocationEntry struct {
 	Coord      Coordinate
 	Time       int64
 	ServerTime null.Time
+	// movedAt ベンチマーカーで椅子をこの位置に動かした日時
+	movedAt time.Time
 }
 
 func (r *ChairLocation) Current() Coordinate {
@@ -90,6 +92,7 @@ func (r *ChairLocation) Dirty() bool {
 func (r *ChairLocation) PlaceTo(location *LocationEntry) {
 	r.mu.Lock()
 	defer r.mu.Unlock()
+	location.movedAt = time.Now()
 	r.history = append(r.history, location)
 	r.current = location
 	r.dirty = true
@@ -99,6 +102,7 @@ func (r *ChairLocation) PlaceTo(location *LocationEntry) {
 func (r *ChairLocation) MoveTo(location *LocationEntry) {
 	r.mu.Lock()
 	defer r.mu.Unlock()
+	location.movedAt = time.Now()
 	r.history = append(r.history, location)
 	r.totalTravelDistance += r.current.Coord.DistanceTo(location.Coord)
 	r.current = location
@@ -111,6 +115,20 @@ func (r *ChairLocation) SetServerTime(serverTime time.Time) {
 	r.current.ServerTime = null.TimeFrom(serverTime)
 }
 
+// CoordsSince since以降に椅子がいた位置を、新しいものから順に返す
+func (r *ChairLocation) CoordsSince(since time.Time) []Coordinate {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	coords := []Coordinate{}
+	for _, entry := range slices.Backward(r.history) {
+		coords = append(coords, entry.Coord)
+		if entry.movedAt.Before(since) {
+			return coords
+		}
+	}
+	return append(coords, r.Initial)
+}
+
 type GetPeriodsByCoordResultEntry struct {
 	Since time.Time
 	Until null.Time
@@ -128,3 +128,17 @@ func TestChair_moveToward(t *testing.T) {
 		})
 	}
 }
+
+func TestChair_evaluateRider(t *testing.T) {
+	c := Chair{
+		Rand: rand.New(rand.NewPCG(rand.Uint64(), rand.Uint64())),
+	}
+	for manner := 1; manner <= 5; manner++ {
+		user := &User{Manner: manner}
+		for range 100 {
+			score := c.evaluateRider(user)
+			assert.True(t, 1 <= score && score <= 5, "評価は1〜5に収まっている")
+			assert.LessOrEqual(t, abs(score-manner), 1, "評価は客のマナーから1以上ずれない")
+		}
+	}
+}
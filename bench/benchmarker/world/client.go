@@ -24,7 +24,7 @@ type UserClient interface {
 	// GetNearbyChairs サーバーから近くの椅子の情報を取得する
 	GetNearbyChairs(ctx *Context, current Coordinate, distance int) (*GetNearbyChairsResponse, error)
 	// GetEstimatedFare サーバーから料金の見積もりを取る
-	GetEstimatedFare(ctx *Context, pickup Coordinate, dest Coordinate) (*GetEstimatedFareResponse, error)
+	GetEstimatedFare(ctx *Context, pickup Coordinate, stops []Coordinate, dest Coordinate) (*GetEstimatedFareResponse, error)
 	// SendEvaluation サーバーに今回の送迎の評価を送信する
 	SendEvaluation(ctx *Context, req *Request, score int) (*SendEvaluationResponse, error)
 	// RegisterPaymentMethods サーバーにユーザーの支払い情報を登録する
@@ -49,8 +49,12 @@ type ChairClient interface {
 	SendChairCoordinate(ctx *Context, chair *Chair) (*SendChairCoordinateResponse, error)
 	// SendAcceptRequest サーバーに配椅子要求を受理することを報告する
 	SendAcceptRequest(ctx *Context, chair *Chair, req *Request) error
+	// SendDenyRequest サーバーに配椅子要求を拒否することを報告する
+	SendDenyRequest(ctx *Context, chair *Chair, req *Request) error
 	// SendDepart サーバーに客が搭乗完了して出発することを報告する
 	SendDepart(ctx *Context, req *Request) error
+	// SendRiderEvaluation サーバーに完了したリクエストの客の評価を送信する
+	SendRiderEvaluation(ctx *Context, req *Request, score int) error
 	// SendActivate サーバーにリクエストの受付開始を通知する
 	SendActivate(ctx *Context, chair *Chair) error
 	// ConnectChairNotificationStream 椅子用の通知ストリームに接続する
@@ -59,15 +63,20 @@ type ChairClient interface {
 
 type SendCreateRequestResponse struct {
 	ServerRequestID string
+	Fare            int
+	SurgeRate       int
 }
 
 type GetRequestsResponse struct {
 	Requests []*RequestHistory
+	// ScheduledRequestIDs マッチングを待っている予約済みのリクエストのサーバー上でのID
+	ScheduledRequestIDs []string
 }
 
 type GetEstimatedFareResponse struct {
-	Fare     int
-	Discount int
+	Fare      int
+	Discount  int
+	SurgeRate int
 }
 
 type GetNearbyChairsResponse struct {
@@ -136,6 +145,7 @@ type OwnerChair struct {
 	RegisteredAt           time.Time
 	TotalDistance          int
 	TotalDistanceUpdatedAt null.Time
+	RetiredAt              null.Time
 }
 
 type SendChairCoordinateResponse struct {
@@ -166,6 +166,25 @@ func RandomTwoCoordinateWithRand(region *Region, distance int, rand *rand.Rand)
 	return c1, c2
 }
 
+// RandomStopsWithRand 地域内で、出発地と目的地の間から経由地を最大n個選ぶ
+// 直前の位置や目的地と同じ位置は経由地にしない
+func RandomStopsWithRand(region *Region, start, dest Coordinate, n int, rand *rand.Rand) []Coordinate {
+	stops := make([]Coordinate, 0, n)
+	previous := start
+	for range n {
+		stop := C(
+			min(start.X, dest.X)+rand.IntN(abs(start.X-dest.X)+1),
+			min(start.Y, dest.Y)+rand.IntN(abs(start.Y-dest.Y)+1),
+		)
+		if !region.Contains(stop) || stop.Equals(previous) || stop.Equals(dest) {
+			continue
+		}
+		stops = append(stops, stop)
+		previous = stop
+	}
+	return stops
+}
+
 func CalculateRandomDetourPoint(start, dest Coordinate, speed int, rand *rand.Rand) Coordinate {
 	halfT := start.DistanceTo(dest) / speed / 2
 	move := halfT * speed
@@ -18,3 +18,24 @@ func TestRandomCoordinateAwayFromHereWithRand(t *testing.T) {
 		assert.Equal(t, distance, prev.DistanceTo(c), "離れる量は常にdistanceと一致しなければならない")
 	}
 }
+
+func TestRandomStopsWithRand(t *testing.T) {
+	r := rand.New(rand.NewPCG(rand.Uint64(), rand.Uint64()))
+	region := NewRegion("test", 0, 0, 100, 100)
+
+	for range 1000 {
+		start, dest := RandomTwoCoordinateWithRand(region, r.IntN(50)+5, r)
+		stops := RandomStopsWithRand(region, start, dest, 3, r)
+		assert.LessOrEqual(t, len(stops), 3)
+
+		// 経由地は出発地と目的地の間から選ばれるので、経由しても遠回りにならない範囲に収まる
+		previous := start
+		for _, stop := range stops {
+			assert.True(t, region.Contains(stop))
+			assert.False(t, stop.Equals(previous), "経由地は直前の位置と同じであってはならない")
+			assert.False(t, stop.Equals(dest), "経由地は目的地と同じであってはならない")
+			assert.Equal(t, start.DistanceTo(dest), start.DistanceTo(stop)+stop.DistanceTo(dest))
+			previous = stop
+		}
+	}
+}
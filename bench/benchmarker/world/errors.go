@@ -22,7 +22,8 @@ const (
 	ErrorCodeFailedToDepart
 	// ErrorCodeFailedToAcceptRequest 椅子がリクエストを受理しようとしたが失敗した
 	ErrorCodeFailedToAcceptRequest
-	_
+	// ErrorCodeFailedToDenyRequest 椅子がリクエストを拒否しようとしたが失敗した
+	ErrorCodeFailedToDenyRequest
 	// ErrorCodeFailedToEvaluate ユーザーが送迎の評価をしようとしたが失敗した
 	ErrorCodeFailedToEvaluate
 	// ErrorCodeEvaluateTimeout ユーザーが送迎の評価をしようとしたがタイムアウトした
@@ -81,6 +82,10 @@ const (
 	ErrorCodeSkippedPaymentButEvaluated
 	// ErrorCodeWrongPaymentRequest 決済サーバーに誤った支払いがリクエストされました
 	ErrorCodeWrongPaymentRequest
+	// ErrorCodeFailedToEvaluateRider 椅子が客の評価をしようとしたが失敗した
+	ErrorCodeFailedToEvaluateRider
+	// ErrorCodeWrongSurgeRate サージ倍率が周辺の需要と供給から想定される範囲にありません
+	ErrorCodeWrongSurgeRate
 )
 
 var CriticalErrorCodes = map[ErrorCode]bool{
@@ -99,6 +104,7 @@ var ErrorTexts = map[ErrorCode]string{
 	ErrorCodeFailedToSendChairCoordinate:                    "椅子の座標送信に失敗しました",
 	ErrorCodeFailedToDepart:                                 "椅子が出発できませんでした",
 	ErrorCodeFailedToAcceptRequest:                          "椅子がライドを受理できませんでした",
+	ErrorCodeFailedToDenyRequest:                            "椅子がライドを拒否できませんでした",
 	ErrorCodeFailedToEvaluate:                               "ユーザーのライド評価に失敗しました",
 	ErrorCodeEvaluateTimeout:                                "ユーザーのライド評価がタイムアウトしました",
 	ErrorCodeFailedToCheckRequestHistory:                    "ユーザーがライド履歴の取得に失敗しました",
@@ -126,6 +132,8 @@ var ErrorTexts = map[ErrorCode]string{
 	ErrorCodeUserReceivedDataIsWrong:                        "ユーザーが受け取った通知の内容が想定と異なります",
 	ErrorCodeSkippedPaymentButEvaluated:                     "評価は完了しているが、支払いが行われていないライドが存在します",
 	ErrorCodeWrongPaymentRequest:                            "決済サーバーに誤った支払いがリクエストされました",
+	ErrorCodeFailedToEvaluateRider:                          "椅子の客の評価に失敗しました",
+	ErrorCodeWrongSurgeRate:                                 "ライドのサージ倍率が周辺の需要と供給から想定される範囲にありません",
 }
 
 type codeError struct {
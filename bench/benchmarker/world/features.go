@@ -0,0 +1,65 @@
+package world
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Features 基本のシナリオに加えて検証する拡張機能
+// 参考実装のうち拡張機能に対応しているのはGoだけなので、既定では全て無効にし、--featuresで指定したものだけ検証する
+type Features struct {
+	// AsyncPayment 支払いが評価のレスポンスの後に非同期で行われることを許す
+	AsyncPayment bool
+	// RejectRides 椅子がマッチしたライドを一定の確率で拒否する
+	RejectRides bool
+	// SurgePricing 配車位置の周辺の需要と供給に応じて運賃が割り増されることを検証する
+	SurgePricing bool
+	// ScheduledRides 一定の確率で配車日時を予約してライドを作成する
+	ScheduledRides bool
+	// MultiStopRides 一定の確率で経由地のあるライドを作成し、経由地に到着したことの通知を検証する
+	MultiStopRides bool
+	// RiderEvaluation 椅子が完了したライドのユーザーを評価する
+	RiderEvaluation bool
+}
+
+// featureNames --featuresで指定する名前と、それぞれが有効にする拡張機能
+var featureNames = map[string]func(f *Features){
+	"async-payment":    func(f *Features) { f.AsyncPayment = true },
+	"multi-stop":       func(f *Features) { f.MultiStopRides = true },
+	"reject":           func(f *Features) { f.RejectRides = true },
+	"rider-evaluation": func(f *Features) { f.RiderEvaluation = true },
+	"scheduled":        func(f *Features) { f.ScheduledRides = true },
+	"surge":            func(f *Features) { f.SurgePricing = true },
+}
+
+// FeatureNames --featuresで指定できる名前の一覧
+func FeatureNames() []string {
+	names := make([]string, 0, len(featureNames))
+	for name := range featureNames {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// ParseFeatures --featuresで指定された名前から有効にする拡張機能を決める。allを指定すると全て有効にする
+func ParseFeatures(names []string) (Features, error) {
+	f := Features{}
+	for _, name := range names {
+		name = strings.TrimSpace(name)
+		switch {
+		case name == "":
+			continue
+		case name == "all":
+			for _, enable := range featureNames {
+				enable(&f)
+			}
+		case featureNames[name] != nil:
+			featureNames[name](&f)
+		default:
+			return Features{}, fmt.Errorf("unknown feature: %s (available: all, %s)", name, strings.Join(FeatureNames(), ", "))
+		}
+	}
+	return f, nil
+}
@@ -0,0 +1,33 @@
+package world
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseFeatures(t *testing.T) {
+	tests := []struct {
+		name     string
+		names    []string
+		expected Features
+		wantErr  bool
+	}{
+		{name: "指定しなければ全て無効", names: nil, expected: Features{}},
+		{name: "指定したものだけ有効", names: []string{"async-payment"}, expected: Features{AsyncPayment: true}},
+		{name: "空白は無視する", names: []string{" async-payment ", ""}, expected: Features{AsyncPayment: true}},
+		{name: "allで全て有効", names: []string{"all"}, expected: Features{AsyncPayment: true, RejectRides: true, SurgePricing: true, ScheduledRides: true, MultiStopRides: true, RiderEvaluation: true}},
+		{name: "知らない名前はエラー", names: []string{"unknown"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := ParseFeatures(tt.names)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
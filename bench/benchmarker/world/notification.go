@@ -8,6 +8,14 @@ type unimplementedNotificationEvent struct{}
 
 func (*unimplementedNotificationEvent) isNotificationEvent() {}
 
+// ChairNotificationEventScheduled 予約したライドは椅子に割り当てられるまでSCHEDULEDなので、椅子には通知されないはず
+type ChairNotificationEventScheduled struct {
+	ServerRequestID string
+	ChairNotificationEvent
+
+	unimplementedNotificationEvent
+}
+
 type ChairNotificationEventMatched struct {
 	ServerRequestID string
 	ChairNotificationEvent
@@ -36,6 +44,18 @@ type ChairNotificationEventCarrying struct {
 	unimplementedNotificationEvent
 }
 
+// ChairNotificationEventWaypoint 椅子が経由地に到着した
+type ChairNotificationEventWaypoint struct {
+	ServerRequestID string
+	ChairNotificationEvent
+	// Stops 経由地
+	Stops []Coordinate
+	// ArrivedStops 到着済みの経由地の数
+	ArrivedStops int
+
+	unimplementedNotificationEvent
+}
+
 type ChairNotificationEventArrived struct {
 	ServerRequestID string
 	ChairNotificationEvent
@@ -61,6 +81,14 @@ type ChairNotificationEventUserPayload struct {
 	Name string
 }
 
+// UserNotificationEventScheduled 配車日時を予約したライドが、マッチングを始めるまで待っている
+type UserNotificationEventScheduled struct {
+	ServerRequestID string
+	UserNotificationEvent
+
+	unimplementedNotificationEvent
+}
+
 type UserNotificationEventMatching struct {
 	ServerRequestID string
 	UserNotificationEvent
@@ -89,6 +117,18 @@ type UserNotificationEventCarrying struct {
 	unimplementedNotificationEvent
 }
 
+// UserNotificationEventWaypoint 椅子が経由地に到着した
+type UserNotificationEventWaypoint struct {
+	ServerRequestID string
+	UserNotificationEvent
+	// Stops 経由地
+	Stops []Coordinate
+	// ArrivedStops 到着済みの経由地の数
+	ArrivedStops int
+
+	unimplementedNotificationEvent
+}
+
 type UserNotificationEventArrived struct {
 	ServerRequestID string
 	UserNotificationEvent
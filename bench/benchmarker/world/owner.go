@@ -240,6 +240,10 @@ func (p *Owner) ValidateChairs(serverSide *GetOwnerChairsResponse, baseTime time
 		if data.Model != chair.Model.Name {
 			return fmt.Errorf("modelが一致しないデータがあります (id: %s, got: %s, want: %s)", chair.ServerID, data.Model, chair.Model.Name)
 		}
+		// ベンチマーカーは椅子を引退させないので、引退日時が入っていてはいけない
+		if data.RetiredAt.Valid {
+			return fmt.Errorf("引退していない椅子にretired_atが設定されています (id: %s)", chair.ServerID)
+		}
 		// アクティブ状態の検査はリクエストのタイミングでズレることがあるので、検査しない
 		//if (data.Active && chair.State != ChairStateActive) || (!data.Active && chair.State != ChairStateInactive) {
 		//	return fmt.Errorf("activeが一致しないデータがあります (id: %s, got: %v, want: %v)", chair.ServerID, data.Active, !data.Active)
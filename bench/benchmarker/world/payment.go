@@ -11,16 +11,25 @@ import (
 type PaymentDB struct {
 	PaymentTokens     *concurrent.SimpleMap[string, *User]
 	CommittedPayments *concurrent.SimpleSlice[*payment.Payment]
+	// PrevalidationPayments 事前検証で登録した決済トークンごとの支払われた額。負荷走行の支払いとしては検証しない
+	PrevalidationPayments *concurrent.SimpleMap[string, *concurrent.SimpleSlice[int]]
 }
 
 func NewPaymentDB() *PaymentDB {
 	return &PaymentDB{
 		PaymentTokens:     concurrent.NewSimpleMap[string, *User](),
 		CommittedPayments: concurrent.NewSimpleSlice[*payment.Payment](),
+
+		PrevalidationPayments: concurrent.NewSimpleMap[string, *concurrent.SimpleSlice[int]](),
 	}
 }
 
 func (db *PaymentDB) Verify(p *payment.Payment) payment.Status {
+	if amounts, ok := db.PrevalidationPayments.Get(p.Token); ok {
+		amounts.Append(p.Amount)
+		return payment.Status{Type: payment.StatusSuccess, Err: nil}
+	}
+
 	user, ok := db.PaymentTokens.Get(p.Token)
 	if !ok {
 		return payment.Status{Type: payment.StatusInvalidToken, Err: nil}
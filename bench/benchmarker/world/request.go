@@ -15,6 +15,10 @@ const (
 	InitialFare = 500
 	// FarePerDistance １距離あたりの運賃
 	FarePerDistance = 100
+	// MinSurgeRate 割り増ししない時のサージ倍率(%)
+	MinSurgeRate = 100
+	// MaxSurgeRate サージ倍率(%)の上限
+	MaxSurgeRate = 200
 )
 
 type RequestStatus int
@@ -60,8 +64,16 @@ type Request struct {
 	PickupPoint Coordinate
 	// DestinationPoint 目的地
 	DestinationPoint Coordinate
+	// Stops 配椅子位置から目的地までに順に経由する位置
+	Stops []Coordinate
+	// ArrivedStops 椅子が到着済みの経由地の数
+	ArrivedStops int
 	// Discount 最大割引額
 	Discount int
+	// SurgeRate サーバーがリクエスト作成時に確定させたサージ倍率(%)
+	SurgeRate int
+	// ScheduledAt 予約した配車日時。予約していなければゼロ値
+	ScheduledAt time.Time
 
 	// Chair 割り当てられた椅子。割り当てられるまでnil
 	Chair *Chair
@@ -87,11 +99,17 @@ type Request struct {
 	BenchRequestedAt time.Time
 	// BenchRequestAcceptTime ベンチがAcceptのリクエストを送った時間(レスポンスが帰ってきた時間ではない)
 	BenchRequestAcceptTime time.Time
+	// BenchEvaluatedAt ベンチが評価のリクエストを送って成功した時間
+	BenchEvaluatedAt time.Time
 
 	// Evaluated リクエストの評価が完了しているかどうか
 	Evaluated atomic.Bool
 	// Paid リクエストの支払いが完了しているかどうか
 	Paid atomic.Bool
+	// matchingStarted 予約したリクエストのマッチングが始まったことを、MATCHINGの通知で知ったかどうか
+	matchingStarted atomic.Bool
+	// surgeState マッチング待ちかどうかが最後に変わりえた日時
+	surgeState surgeState
 
 	Statuses RequestStatuses
 }
@@ -113,12 +131,65 @@ func (r *Request) SetID(id RequestID) {
 
 // Sales 売り上げ
 func (r *Request) Sales() int {
-	return InitialFare + r.PickupPoint.DistanceTo(r.DestinationPoint)*FarePerDistance
+	return InitialFare + r.MeteredFare()
 }
 
 // Fare ユーザーが支払う料金
 func (r *Request) Fare() int {
-	return InitialFare + max(r.PickupPoint.DistanceTo(r.DestinationPoint)*FarePerDistance-r.Discount, 0)
+	return InitialFare + max(r.MeteredFare()-r.Discount, 0)
+}
+
+// MeteredFare 距離に応じた運賃にサージ倍率を掛けたもの。初乗り運賃には倍率を掛けない
+func (r *Request) MeteredFare() int {
+	surgeRate := r.SurgeRate
+	if surgeRate == 0 {
+		surgeRate = MinSurgeRate
+	}
+	return r.RouteDistance() * FarePerDistance * surgeRate / 100
+}
+
+// RouteDistance 配椅子位置から経由地を順に回って目的地に着くまでの距離
+func (r *Request) RouteDistance() int {
+	distance := 0
+	current := r.PickupPoint
+	for _, stop := range r.Stops {
+		distance += current.DistanceTo(stop)
+		current = stop
+	}
+	return distance + current.DistanceTo(r.DestinationPoint)
+}
+
+// NextTarget 乗車後に椅子が次に向かう位置。全ての経由地に到着していれば目的地になる
+func (r *Request) NextTarget() Coordinate {
+	if r.ArrivedStops < len(r.Stops) {
+		return r.Stops[r.ArrivedStops]
+	}
+	return r.DestinationPoint
+}
+
+// ValidateWaypoint サーバーから通知された経由地と到着済みの経由地の数を検証する
+// サーバーは椅子が位置を送ってから到着を記録するので、到着済みの数はベンチマーカーの数より少ないことがある
+func (r *Request) ValidateWaypoint(stops []Coordinate, arrivedStops int) error {
+	if len(stops) != len(r.Stops) {
+		return fmt.Errorf("経由地の数が一致しません (expected:%d, actual:%d)", len(r.Stops), len(stops))
+	}
+	for i, stop := range stops {
+		if !stop.Equals(r.Stops[i]) {
+			return fmt.Errorf("%d番目の経由地が一致しません (expected:%s, actual:%s)", i+1, r.Stops[i], stop)
+		}
+	}
+	if arrivedStops < 1 || arrivedStops > r.ArrivedStops {
+		return fmt.Errorf("到着済みの経由地の数が不正です (arrived:%d, actual:%d)", r.ArrivedStops, arrivedStops)
+	}
+	return nil
+}
+
+// ValidateSurgeRate サーバーが返したサージ倍率が取りうる範囲に収まっているか検証する。周辺の需要と供給に見合っているかはcheckSurgeRateで検証する
+func ValidateSurgeRate(surgeRate int) error {
+	if surgeRate < MinSurgeRate || surgeRate > MaxSurgeRate {
+		return fmt.Errorf("サージ倍率が範囲外です (min:%d, max:%d, actual:%d)", MinSurgeRate, MaxSurgeRate, surgeRate)
+	}
+	return nil
 }
 
 // ActualDiscount 実際に割り引いた価格
@@ -158,7 +229,7 @@ func (r *Request) CalculateEvaluation() Evaluation {
 	}
 	{
 		// 乗車時間誤差評価
-		idealTime := neededTime(r.PickupPoint.DistanceTo(r.DestinationPoint), r.Chair.Model.Speed)
+		idealTime := neededTime(r.RouteDistance(), r.Chair.Model.Speed)
 		actualTime := int(r.ArrivedAt - r.PickedUpAt)
 		if actualTime-idealTime < 5 {
 			// 理想時間との誤差が5ticks以内ならOK
@@ -0,0 +1,26 @@
+package world
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequest_ValidateWaypoint(t *testing.T) {
+	req := &Request{
+		PickupPoint:      C(0, 0),
+		Stops:            []Coordinate{C(5, 0), C(5, 5)},
+		DestinationPoint: C(10, 10),
+		ArrivedStops:     1,
+	}
+
+	assert.NoError(t, req.ValidateWaypoint([]Coordinate{C(5, 0), C(5, 5)}, 1))
+	assert.Error(t, req.ValidateWaypoint([]Coordinate{C(5, 0), C(5, 5)}, 0), "WAYPOINTは経由地に到着してから通知される")
+	assert.Error(t, req.ValidateWaypoint([]Coordinate{C(5, 0), C(5, 5)}, 2), "椅子がまだ到着していない経由地は到着済みにならない")
+	assert.Error(t, req.ValidateWaypoint([]Coordinate{C(5, 0)}, 1), "経由地の数が一致しなければならない")
+	assert.Error(t, req.ValidateWaypoint([]Coordinate{C(5, 5), C(5, 0)}, 1), "経由地の順番が一致しなければならない")
+
+	req.ArrivedStops = 2
+	assert.NoError(t, req.ValidateWaypoint([]Coordinate{C(5, 0), C(5, 5)}, 1), "サーバーの到着の記録は椅子の移動より遅れることがある")
+	assert.NoError(t, req.ValidateWaypoint([]Coordinate{C(5, 0), C(5, 5)}, 2))
+}
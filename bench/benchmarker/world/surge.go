@@ -0,0 +1,128 @@
+package world
+
+import (
+	"fmt"
+	"sync/atomic"
+	"time"
+)
+
+// サーバーは、配車位置からSurgeRegionDistance以内でマッチングを待っているライドの数を、同じ範囲の空いている椅子の数(0台なら1台)で割った比でサージ倍率を決める
+// ベンチマーカーは自身が把握しているライドと椅子の状態から倍率を求めて検証する
+// 状態はサーバーとベンチマーカーで反映されるタイミングがずれるので、倍率を取得する前後で状態が変わりえたライドと椅子は、数えた場合と数えなかった場合の両方を許す
+
+const (
+	// SurgeRegionDistance 需要と供給を数える、配車位置からのマンハッタン距離
+	SurgeRegionDistance = 50
+	// surgeStateTolerance ベンチマーカーとサーバーで状態の反映がずれうる時間
+	surgeStateTolerance = 3 * time.Second
+)
+
+// surgeState ライドや椅子の、サージ倍率に関わる状態をベンチマーカーが最後に変えた、または変わったことを知った日時
+type surgeState struct {
+	changedAt atomic.Int64
+}
+
+// touch 状態が変わりうることを記録する。サーバーへのリクエストで状態を変える場合は、送る前とレスポンスを受け取った後の両方で呼ぶ
+func (s *surgeState) touch() {
+	s.changedAt.Store(time.Now().UnixNano())
+}
+
+// stableSince since以降に状態が変わっていないかどうか
+func (s *surgeState) stableSince(since time.Time) bool {
+	return s.changedAt.Load() < since.UnixNano()
+}
+
+// calculateSurgeRate マッチング待ちのライドの数と空いている椅子の数からサージ倍率(%)を求める
+func calculateSurgeRate(waitingRequests, freeChairs int) int {
+	if waitingRequests == 0 {
+		return MinSurgeRate
+	}
+	return min(max(MinSurgeRate*waitingRequests/max(freeChairs, 1), MinSurgeRate), MaxSurgeRate)
+}
+
+// surgeRateRange since以降にサーバーが配車位置について返しうるサージ倍率の範囲を、requestsと全ての椅子の状態から求める
+func (w *World) surgeRateRange(since time.Time, pickup Coordinate, requests []*Request) (lower, upper int) {
+	inRegion := func(c Coordinate) bool {
+		return pickup.DistanceTo(c) <= SurgeRegionDistance
+	}
+
+	// waitingLow 確実にマッチングを待っているライドの数、waitingHigh マッチングを待っている可能性のあるライドの数
+	waitingLow, waitingHigh := 0, 0
+	for _, req := range requests {
+		if !inRegion(req.PickupPoint) {
+			continue
+		}
+		req.Statuses.RLock()
+		waiting := req.Statuses.Desired == RequestStatusMatching && req.Chair == nil
+		req.Statuses.RUnlock()
+
+		// 予約したライドは、MATCHINGの通知を受け取るまでサーバーでいつマッチングを始めたかわからない
+		stable := req.surgeState.stableSince(since) && (req.ScheduledAt.IsZero() || req.matchingStarted.Load())
+		switch {
+		case !stable:
+			waitingHigh++
+		case waiting:
+			waitingLow++
+			waitingHigh++
+		}
+	}
+
+	// freeLow 確実に周辺で空いている椅子の数、freeHigh 周辺で空いている可能性のある椅子の数
+	freeLow, freeHigh := 0, 0
+	for chair := range w.ChairDB.Values() {
+		stable := chair.surgeState.stableSince(since)
+		free := chair.State == ChairStateActive && w.EmptyChairs.Has(chair)
+		if stable && !free {
+			continue
+		}
+		in, out := 0, 0
+		for _, coord := range chair.Location.CoordsSince(since) {
+			if inRegion(coord) {
+				in++
+			} else {
+				out++
+			}
+		}
+		if in == 0 {
+			continue
+		}
+		freeHigh++
+		if stable && out == 0 {
+			freeLow++
+		}
+	}
+
+	return calculateSurgeRate(waitingLow, freeHigh), calculateSurgeRate(waitingHigh, freeLow)
+}
+
+// checkSurgeRate サーバーが返したサージ倍率を検証する。sentAtは倍率を取得するリクエストを送った日時
+// 周辺の需要と供給に見合っているかは、サーバーで起きた状態の変化がベンチマーカーに届くのを待ってから検証する
+func (w *World) checkSurgeRate(sentAt time.Time, self *Request, surgeRate int) error {
+	if err := ValidateSurgeRate(surgeRate); err != nil {
+		return WrapCodeError(ErrorCodeWrongSurgeRate, err)
+	}
+
+	// サーバーが倍率を求めた時点で存在しうるのは、今までに作成したか作成中のライドだけ
+	requests := []*Request{}
+	for u := range w.UserDB.Values() {
+		if req := u.Request; req != nil && req != self {
+			requests = append(requests, req)
+		}
+	}
+	for req := range w.creatingRequests.Iter() {
+		if req != self {
+			requests = append(requests, req)
+		}
+	}
+
+	time.AfterFunc(surgeStateTolerance, func() {
+		if w.finished.Load() {
+			return
+		}
+		lower, upper := w.surgeRateRange(sentAt.Add(-surgeStateTolerance), self.PickupPoint, requests)
+		if surgeRate < lower || surgeRate > upper {
+			w.handleTickError(WrapCodeError(ErrorCodeWrongSurgeRate, fmt.Errorf("サージ倍率が周辺の需要と供給に見合っていません (pickup: %s, min:%d, max:%d, actual:%d)", self.PickupPoint, lower, upper, surgeRate)))
+		}
+	})
+	return nil
+}
@@ -0,0 +1,107 @@
+package world
+
+import (
+	"testing"
+	"time"
+
+	"github.com/isucon/isucon14/bench/internal/concurrent"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCalculateSurgeRate(t *testing.T) {
+	tests := []struct {
+		name            string
+		waitingRequests int
+		freeChairs      int
+		expected        int
+	}{
+		{name: "待っているライドが無ければ割り増さない", waitingRequests: 0, freeChairs: 0, expected: MinSurgeRate},
+		{name: "空いている椅子の方が多ければ割り増さない", waitingRequests: 2, freeChairs: 5, expected: MinSurgeRate},
+		{name: "待っているライドの数と空いている椅子の数の比で割り増す", waitingRequests: 3, freeChairs: 2, expected: 150},
+		{name: "空いている椅子が無ければ1台あるものとして扱う", waitingRequests: 1, freeChairs: 0, expected: 100},
+		{name: "上限を超えて割り増さない", waitingRequests: 10, freeChairs: 1, expected: MaxSurgeRate},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, calculateSurgeRate(tt.waitingRequests, tt.freeChairs))
+		})
+	}
+}
+
+func TestWorld_surgeRateRange(t *testing.T) {
+	pickup := C(0, 0)
+	newWorld := func() *World {
+		return &World{
+			ChairDB:     NewGenericDB[ChairID, *Chair](),
+			EmptyChairs: concurrent.NewSimpleSet[*Chair](),
+		}
+	}
+	addChair := func(w *World, coord Coordinate, free bool) *Chair {
+		chair := w.ChairDB.Create(&Chair{State: ChairStateActive, Location: ChairLocation{Initial: coord}})
+		chair.Location.PlaceTo(&LocationEntry{Coord: coord})
+		if free {
+			w.EmptyChairs.Add(chair)
+		}
+		return chair
+	}
+	newRequest := func(coord Coordinate) *Request {
+		return &Request{PickupPoint: coord, Statuses: RequestStatuses{Desired: RequestStatusMatching}}
+	}
+
+	t.Run("状態が変わっていなければ倍率は一つに決まる", func(t *testing.T) {
+		w := newWorld()
+		addChair(w, C(10, 10), true)
+		addChair(w, C(-20, 30), true)
+		addChair(w, C(100, 100), true)
+		addChair(w, C(0, 0), false)
+		requests := []*Request{newRequest(C(1, 1)), newRequest(C(-5, 5)), newRequest(C(25, 25)), newRequest(C(100, 0))}
+
+		lower, upper := w.surgeRateRange(time.Now().Add(time.Hour), pickup, requests)
+		assert.Equal(t, 150, lower)
+		assert.Equal(t, 150, upper)
+	})
+
+	t.Run("状態が変わりえたライドと椅子は数えた場合と数えなかった場合の両方を許す", func(t *testing.T) {
+		w := newWorld()
+		addChair(w, C(10, 10), true)
+		busy := addChair(w, C(-10, -10), false)
+		requests := []*Request{newRequest(C(1, 1)), newRequest(C(2, 2)), newRequest(C(3, 3))}
+
+		since := time.Now()
+		busy.surgeState.touch()
+		requests[2].surgeState.touch()
+
+		lower, upper := w.surgeRateRange(since, pickup, requests)
+		assert.Equal(t, calculateSurgeRate(2, 2), lower)
+		assert.Equal(t, calculateSurgeRate(3, 1), upper)
+	})
+
+	t.Run("範囲を出入りした椅子は周辺にいた場合といなかった場合の両方を許す", func(t *testing.T) {
+		w := newWorld()
+		moving := addChair(w, C(100, 100), true)
+		requests := []*Request{newRequest(C(1, 1)), newRequest(C(2, 2))}
+
+		since := time.Now()
+		moving.Location.MoveTo(&LocationEntry{Coord: C(10, 10)})
+
+		lower, upper := w.surgeRateRange(since, pickup, requests)
+		assert.Equal(t, calculateSurgeRate(2, 1), lower)
+		assert.Equal(t, calculateSurgeRate(2, 0), upper)
+	})
+
+	t.Run("予約したライドはMATCHINGの通知を受け取るまで待っているか分からない", func(t *testing.T) {
+		w := newWorld()
+		scheduled := newRequest(C(1, 1))
+		scheduled.ScheduledAt = time.Now()
+		requests := []*Request{newRequest(C(2, 2)), scheduled}
+
+		lower, upper := w.surgeRateRange(time.Now().Add(time.Hour), pickup, requests)
+		assert.Equal(t, calculateSurgeRate(1, 0), lower)
+		assert.Equal(t, calculateSurgeRate(2, 0), upper)
+
+		scheduled.matchingStarted.Store(true)
+		lower, upper = w.surgeRateRange(time.Now().Add(time.Hour), pickup, requests)
+		assert.Equal(t, calculateSurgeRate(2, 0), lower)
+		assert.Equal(t, calculateSurgeRate(2, 0), upper)
+	})
+}
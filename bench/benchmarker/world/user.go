@@ -24,6 +24,22 @@ const (
 	UserStateActive
 )
 
+// PaymentTimeout 支払いを非同期で行う実装で、評価してから支払いが行われるまで待つ時間
+const PaymentTimeout = 10 * time.Second
+
+const (
+	// ScheduledRequestPercentage 配車日時を予約してリクエストする確率(%)
+	ScheduledRequestPercentage = 10
+	// ScheduledRequestAdvance 予約する場合に、リクエストからどれだけ先の配車日時を予約するか
+	ScheduledRequestAdvance = 5 * time.Second
+	// MultiStopRequestPercentage 経由地を指定してリクエストする確率(%)
+	MultiStopRequestPercentage = 10
+	// MaxRequestStops リクエストに指定できる経由地の数
+	MaxRequestStops = 3
+	// LowMannerUserPercentage 客としてのマナーが悪いユーザーの割合(%)
+	LowMannerUserPercentage = 10
+)
+
 type UserID int
 
 type User struct {
@@ -47,6 +63,8 @@ type User struct {
 	RequestHistory []*Request
 	// TotalEvaluation 完了したリクエストの平均評価
 	TotalEvaluation int
+	// Manner 客としてのマナー(1〜5)。椅子はこれを基準に客を評価する
+	Manner int
 	// Client webappへのクライアント
 	Client UserClient
 	// Rand 専用の乱数
@@ -84,6 +102,14 @@ func (u *User) String() string {
 	return fmt.Sprintf("User{id=%d,totalReqs=%d}", u.ID, len(u.RequestHistory))
 }
 
+// randomManner 客としてのマナーを決める。一部のユーザーはマナーが悪く、椅子から低く評価され続ける
+func randomManner(r *rand.Rand) int {
+	if r.IntN(100) < LowMannerUserPercentage {
+		return 1 + r.IntN(2)
+	}
+	return 4 + r.IntN(2)
+}
+
 func (u *User) SetID(id UserID) {
 	u.ID = id
 }
@@ -141,9 +167,22 @@ func (u *User) Tick(ctx *Context) error {
 
 		switch u.Request.Statuses.User {
 		case RequestStatusMatching:
+			// 予約したリクエストは配車日時まではマッチングを待たせてよいので、配車日時までの時間はリクエストした時間とみなさない
+			waitingFrom := u.Request.BenchRequestedAt
+			if u.Request.ScheduledAt.After(waitingFrom) {
+				waitingFrom = u.Request.ScheduledAt
+			}
+			if time.Now().Before(u.Request.ScheduledAt) {
+				u.Request.Statuses.Lock()
+				if u.Request.Statuses.Chair == RequestStatusMatching {
+					u.Request.RequestedAt = ctx.CurrentTime()
+				}
+				u.Request.Statuses.Unlock()
+			}
+
 			// マッチングされるまで待機する
 			// 30秒待ってもマッチされない場合は、サービスとして重大な問題があるのでクリティカルエラーとして落とす
-			if time.Now().Sub(u.Request.BenchRequestedAt) >= 30*time.Second {
+			if time.Now().Sub(waitingFrom) >= 30*time.Second {
 				return CodeError(ErrorCodeMatchingTimeout)
 			}
 
@@ -173,7 +212,10 @@ func (u *User) Tick(ctx *Context) error {
 				}
 
 				u.Request.Statuses.Lock()
+				// 評価が受理されると、サーバーでは椅子が空く
+				u.Request.Chair.surgeState.touch()
 				res, err := u.Client.SendEvaluation(ctx, u.Request, score)
+				u.Request.Chair.surgeState.touch()
 				if err != nil {
 					u.Request.Statuses.Unlock()
 					if errors.Is(err, context.DeadlineExceeded) {
@@ -185,9 +227,12 @@ func (u *User) Tick(ctx *Context) error {
 				// サーバーが評価を受理したので完了状態になるのを待機する
 				u.Request.CompletedAt = ctx.CurrentTime()
 				u.Request.ServerCompletedAt = res.CompletedAt
+				u.Request.BenchEvaluatedAt = time.Now()
 				u.Request.Statuses.Desired = RequestStatusCompleted
 				u.Request.Evaluated.Store(true)
-				if !u.Request.Paid.Load() {
+				if !u.World.Features.AsyncPayment && !u.Request.Paid.Load() {
+					// 評価のレスポンスが返るまでに支払いが行われている必要がある
+					u.Request.Statuses.Unlock()
 					return CodeError(ErrorCodeSkippedPaymentButEvaluated)
 				}
 				if requests := len(u.RequestHistory); requests == 1 {
@@ -205,6 +250,16 @@ func (u *User) Tick(ctx *Context) error {
 			}
 
 		case RequestStatusCompleted:
+			// 非同期の支払いを許す場合は、評価のレスポンスの後の支払いを待つ。それ以外は評価の時点で支払われている
+			// 決済の記録は評価と同じトランザクションでコミットされ、必ず送られるので、遅れても売上の整合性は崩れない
+			// ただし一定時間待っても支払われない場合は、サービスとして重大な問題があるのでクリティカルエラーとして落とす
+			if !u.Request.Paid.Load() {
+				if time.Since(u.Request.BenchEvaluatedAt) >= PaymentTimeout {
+					return CodeError(ErrorCodeSkippedPaymentButEvaluated)
+				}
+				break
+			}
+
 			// 進行中のリクエストが無い状態にする
 			u.Request = nil
 
@@ -271,6 +326,10 @@ func (u *User) CheckRequestHistory(ctx *Context) error {
 	if len(res.Requests) != len(u.RequestHistory) {
 		return fmt.Errorf("ライドの数が想定数と一致していません: expected=%d, got=%d", len(u.RequestHistory), len(res.Requests))
 	}
+	// 履歴を確認するのは進行中のリクエストが無い時なので、予約済みのライドも無い
+	if len(res.ScheduledRequestIDs) > 0 {
+		return fmt.Errorf("完了したライドが予約済みのライドに含まれています: id=%s", res.ScheduledRequestIDs[0])
+	}
 
 	historyMap := lo.KeyBy(u.RequestHistory, func(r *Request) string { return r.ServerID })
 	for _, req := range res.Requests {
@@ -320,6 +379,16 @@ func (u *User) CreateRequest(ctx *Context) error {
 		},
 	}
 
+	// 経由地を検証する場合は、一部のユーザーが目的地までに経由地を回る
+	if u.World.Features.MultiStopRides && u.Rand.IntN(100) < MultiStopRequestPercentage {
+		req.Stops = RandomStopsWithRand(u.Region, pickup, dest, u.Rand.IntN(MaxRequestStops)+1, u.Rand)
+	}
+
+	// 予約を検証する場合は、一部のユーザーが配車日時を予約する
+	if u.World.Features.ScheduledRides && u.Rand.IntN(100) < ScheduledRequestPercentage {
+		req.ScheduledAt = time.Now().Add(ScheduledRequestAdvance)
+	}
+
 	useInvCoupon := false
 	switch {
 	// 初回利用の割引を適用
@@ -350,18 +419,44 @@ func (u *User) CreateRequest(ctx *Context) error {
 		return nil
 	}
 
-	estimation, err := u.Client.GetEstimatedFare(ctx, pickup, dest)
+	estimatedAt := time.Now()
+	estimation, err := u.Client.GetEstimatedFare(ctx, pickup, req.Stops, dest)
 	if err != nil {
 		return WrapCodeError(ErrorCodeFailedToCreateRequest, err)
 	}
+	// サージ倍率を検証しない場合は、割り増さないものとして料金を検証する
+	req.SurgeRate = MinSurgeRate
+	if u.World.Features.SurgePricing {
+		// サージ倍率は周辺の需要と供給で決まるので、それに見合っているかは別に検証し、サーバーの値で料金を検証する
+		if err := u.World.checkSurgeRate(estimatedAt, req, estimation.SurgeRate); err != nil {
+			return err
+		}
+		req.SurgeRate = estimation.SurgeRate
+	}
 	if req.ActualDiscount() != estimation.Discount || req.Fare() != estimation.Fare {
 		return WrapCodeError(ErrorCodeFailedToCreateRequest, errors.New("ライド料金の見積もり金額が誤っています"))
 	}
 
+	// 他のユーザーのサージ倍率の検証で、作成中のライドも数えられるようにする
+	u.World.creatingRequests.Add(req)
+	defer u.World.creatingRequests.Delete(req)
+	createdAt := time.Now()
+	req.surgeState.touch()
 	res, err := u.Client.SendCreateRequest(ctx, req)
+	req.surgeState.touch()
 	if err != nil {
 		return WrapCodeError(ErrorCodeFailedToCreateRequest, err)
 	}
+	if u.World.Features.SurgePricing {
+		// 見積もりから作成までの間に倍率が変わることがあるので、作成時に確定した倍率で以降の料金を検証する
+		if err := u.World.checkSurgeRate(createdAt, req, res.SurgeRate); err != nil {
+			return err
+		}
+		req.SurgeRate = res.SurgeRate
+	}
+	if req.Fare() != res.Fare {
+		return WrapCodeError(ErrorCodeFailedToCreateRequest, fmt.Errorf("ライドの運賃が誤っています (expected:%d, actual:%d)", req.Fare(), res.Fare))
+	}
 	req.ServerID = res.ServerRequestID
 	req.BenchRequestedAt = time.Now()
 	u.Request = req
@@ -423,6 +518,18 @@ func (u *User) ChangeRequestStatus(status RequestStatus, serverRequestID string,
 
 func (u *User) HandleNotification(event NotificationEvent) error {
 	switch data := event.(type) {
+	case *UserNotificationEventScheduled:
+		// 予約したライドはマッチングを始めるまでSCHEDULEDになるが、ベンチマーカーではMATCHINGと同じものとして扱う
+		request := u.Request
+		if request == nil || request.ServerID != data.ServerRequestID || request.ScheduledAt.IsZero() {
+			return WrapCodeError(ErrorCodeUnexpectedUserRequestStatusTransitionOccurred, fmt.Errorf("予約していないライドがSCHEDULEDになりました (ride_id: %s)", data.ServerRequestID))
+		}
+		err := u.ChangeRequestStatus(RequestStatusMatching, data.ServerRequestID, func() error {
+			return u.ValidateNotificationEvent(data.ServerRequestID, data.UserNotificationEvent, true)
+		})
+		if err != nil {
+			return err
+		}
 	case *UserNotificationEventMatching:
 		err := u.ChangeRequestStatus(RequestStatusMatching, data.ServerRequestID, func() error {
 			return u.ValidateNotificationEvent(data.ServerRequestID, data.UserNotificationEvent, true)
@@ -430,6 +537,10 @@ func (u *User) HandleNotification(event NotificationEvent) error {
 		if err != nil {
 			return err
 		}
+		if request := u.Request; request != nil && request.ServerID == data.ServerRequestID && !request.ScheduledAt.IsZero() {
+			request.matchingStarted.Store(true)
+			request.surgeState.touch()
+		}
 	case *UserNotificationEventDispatching:
 		err := u.ChangeRequestStatus(RequestStatusDispatching, data.ServerRequestID, func() error {
 			if err := u.ValidateNotificationEvent(data.ServerRequestID, data.UserNotificationEvent, false); err != nil {
@@ -469,6 +580,24 @@ func (u *User) HandleNotification(event NotificationEvent) error {
 		if err != nil {
 			return err
 		}
+	case *UserNotificationEventWaypoint:
+		// 経由地への到着はベンチマーカーではCARRYINGの途中として扱う
+		err := u.ChangeRequestStatus(RequestStatusCarrying, data.ServerRequestID, func() error {
+			if err := u.Request.ValidateWaypoint(data.Stops, data.ArrivedStops); err != nil {
+				return fmt.Errorf("ride_id: %s, %w", data.ServerRequestID, err)
+			}
+			if u.validatedRideNotificationEvent != nil {
+				return compareUserNotificationEvent(data.ServerRequestID, *u.validatedRideNotificationEvent, data.UserNotificationEvent)
+			}
+			if err := u.ValidateNotificationEvent(data.ServerRequestID, data.UserNotificationEvent, false); err != nil {
+				return err
+			}
+			u.validatedRideNotificationEvent = &data.UserNotificationEvent
+			return nil
+		})
+		if err != nil {
+			return err
+		}
 	case *UserNotificationEventArrived:
 		err := u.ChangeRequestStatus(RequestStatusArrived, data.ServerRequestID, func() error {
 			if u.validatedRideNotificationEvent != nil {
@@ -41,6 +41,8 @@ type World struct {
 	PaymentDB *PaymentDB
 	// Client webappへのクライアント
 	Client WorldClient
+	// Features 検証する拡張機能
+	Features Features
 	// RootRand ルートの乱数生成器
 	RootRand *rand.Rand
 	// CompletedRequestChan 完了したリクエストのチャンネル
@@ -49,6 +51,8 @@ type World struct {
 	ErrorCounter *ErrorCounter
 	// EmptyChairs 空車椅子マップ
 	EmptyChairs *concurrent.SimpleSet[*Chair]
+	// creatingRequests 作成のリクエストを送ってから、まだRequestDBに登録していないリクエスト
+	creatingRequests *concurrent.SimpleSet[*Request]
 
 	tickTimeout      time.Duration
 	timeoutTicker    *time.Ticker
@@ -74,7 +78,7 @@ type World struct {
 	finished atomic.Bool
 }
 
-func NewWorld(tickTimeout time.Duration, completedRequestChan chan *Request, client WorldClient, contestantLogger *slog.Logger) *World {
+func NewWorld(tickTimeout time.Duration, completedRequestChan chan *Request, client WorldClient, features Features, contestantLogger *slog.Logger) *World {
 	return &World{
 		Regions: []*Region{
 			NewRegion("チェアタウン", 0, 0, 100, 100),
@@ -86,10 +90,12 @@ func NewWorld(tickTimeout time.Duration, completedRequestChan chan *Request, cli
 		RequestDB:            NewRequestDB(),
 		PaymentDB:            NewPaymentDB(),
 		Client:               client,
+		Features:             features,
 		RootRand:             random.NewLockedRand(rand.NewPCG(0, 0)),
 		CompletedRequestChan: completedRequestChan,
 		ErrorCounter:         NewErrorCounter(),
 		EmptyChairs:          concurrent.NewSimpleSet[*Chair](),
+		creatingRequests:     concurrent.NewSimpleSet[*Request](),
 		tickTimeout:          tickTimeout,
 		timeoutTicker:        time.NewTicker(tickTimeout),
 		criticalErrorCh:      make(chan error),
@@ -230,6 +236,7 @@ func (w *World) CreateUser(ctx *Context, args *CreateUserArgs) (*User, error) {
 		Invited:           args.Inviter != nil,
 		notificationQueue: make(chan NotificationEvent, 500),
 	}
+	u.Manner = randomManner(u.Rand)
 	w.PaymentDB.PaymentTokens.Set(u.PaymentToken, u)
 	result := w.UserDB.Create(u)
 	args.Region.AddUser(u)
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log/slog"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/isucon/isucandar"
@@ -13,6 +14,7 @@ import (
 
 	"github.com/isucon/isucon14/bench/benchmarker/metrics"
 	"github.com/isucon/isucon14/bench/benchmarker/scenario"
+	"github.com/isucon/isucon14/bench/benchmarker/world"
 	"github.com/isucon/isucon14/bench/benchrun"
 	"github.com/isucon/isucon14/bench/benchrun/gen/isuxportal/resources"
 )
@@ -36,6 +38,8 @@ var (
 	exportMetrics bool
 	// 静的ファイルのチェックをスキップするかどうか
 	skipStaticFileSanityCheck bool
+	// 検証する拡張機能
+	features []string
 )
 
 var jst = time.FixedZone("Asia/Tokyo", 9*60*60)
@@ -89,10 +93,15 @@ var runCmd = &cobra.Command{
 
 		slog.Debug("target", slog.String("targetURL", targetURL), slog.String("targetAddr", targetAddr), slog.String("benchrun.GetTargetAddress()", benchrun.GetTargetAddress()), slog.String("paymentURL", paymentURL))
 
+		enabledFeatures, err := world.ParseFeatures(features)
+		if err != nil {
+			return err
+		}
+
 		if postValidationMode {
 			contestantLogger.Info("post validationを実行します")
 			passed := false
-			if err := scenario.PostValidation(cmd.Context(), targetURL, targetAddr); err != nil {
+			if err := scenario.PostValidation(cmd.Context(), targetURL, targetAddr, enabledFeatures); err != nil {
 				contestantLogger.Error(err.Error())
 			} else {
 				passed = true
@@ -110,7 +119,7 @@ var runCmd = &cobra.Command{
 			return nil
 		}
 
-		s := scenario.NewScenario(targetURL, targetAddr, paymentURL, paymentBindPort, contestantLogger, reporter, otel.Meter("isucon14_benchmarker"), loadTimeoutSeconds == 0, skipStaticFileSanityCheck)
+		s := scenario.NewScenario(targetURL, targetAddr, paymentURL, paymentBindPort, contestantLogger, reporter, otel.Meter("isucon14_benchmarker"), loadTimeoutSeconds == 0, skipStaticFileSanityCheck, enabledFeatures)
 
 		b, err := isucandar.NewBenchmark(
 			isucandar.WithoutPanicRecover(),
@@ -156,5 +165,6 @@ func init() {
 	runCmd.Flags().BoolVar(&postValidationMode, "only-post-validation", false, "post validation mode")
 	runCmd.Flags().BoolVar(&exportMetrics, "metrics", false, "whether to output metrics")
 	runCmd.Flags().BoolVarP(&skipStaticFileSanityCheck, "skip-static-sanity-check", "s", false, "skip static file validation")
+	runCmd.Flags().StringSliceVar(&features, "features", nil, fmt.Sprintf("extended features to benchmark, which only the Go implementation supports (all, %s)", strings.Join(world.FeatureNames(), ", ")))
 	rootCmd.AddCommand(runCmd)
 }
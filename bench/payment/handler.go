@@ -127,14 +127,16 @@ func writeRandomError(w http.ResponseWriter) {
 }
 
 type ResponsePayment struct {
-	Amount int    `json:"amount"`
-	Status string `json:"status"`
+	Amount         int    `json:"amount"`
+	RefundedAmount int    `json:"refunded_amount"`
+	Status         string `json:"status"`
 }
 
 func NewResponsePayment(p *Payment) ResponsePayment {
 	return ResponsePayment{
-		Amount: p.Amount,
-		Status: p.Status.Type.String(),
+		Amount:         p.Amount,
+		RefundedAmount: p.RefundedAmount(),
+		Status:         p.Status.Type.String(),
 	}
 }
 
@@ -39,6 +39,7 @@ type Payment struct {
 	Amount         int
 	Status         Status
 	locked         atomic.Bool
+	refundedAmount atomic.Int64
 }
 
 func NewPayment(idk string) *Payment {
@@ -49,3 +50,13 @@ func NewPayment(idk string) *Payment {
 	p.locked.Store(false)
 	return p
 }
+
+// RefundedAmount これまでに返金した額の合計
+func (p *Payment) RefundedAmount() int {
+	return int(p.refundedAmount.Load())
+}
+
+// Refundable 成功した決済で、返金額の合計が決済額を超えなければ返金できる
+func (p *Payment) Refundable(amount int) bool {
+	return p.Status.Type == StatusSuccess && amount > 0 && p.RefundedAmount()+amount <= p.Amount
+}
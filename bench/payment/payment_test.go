@@ -12,3 +12,19 @@ func TestNewPayment(t *testing.T) {
 	assert.Equal(t, StatusInitial, p.Status.Type)
 	assert.False(t, p.locked.Load())
 }
+
+func TestPayment_Refundable(t *testing.T) {
+	p := NewPayment("test")
+	p.Amount = 1000
+	assert.False(t, p.Refundable(100), "処理中の決済は返金できない")
+
+	p.Status = Status{Type: StatusSuccess}
+	assert.False(t, p.Refundable(0))
+	assert.True(t, p.Refundable(1000))
+	assert.False(t, p.Refundable(1001))
+
+	p.refundedAmount.Add(600)
+	assert.Equal(t, 600, p.RefundedAmount())
+	assert.True(t, p.Refundable(400))
+	assert.False(t, p.Refundable(401))
+}
@@ -0,0 +1,94 @@
+package payment
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+)
+
+type Refund struct {
+	IdempotencyKey        string
+	PaymentIdempotencyKey string
+	Token                 string
+	Amount                int
+	Status                Status
+}
+
+type PostRefundRequest struct {
+	// PaymentIdempotencyKey 返金する決済を送った時のIdempotency-Key
+	PaymentIdempotencyKey string `json:"payment_idempotency_key"`
+	Amount                int    `json:"amount"`
+}
+
+func (r *PostRefundRequest) IsSamePayload(token string, refund *Refund) bool {
+	return token == refund.Token && r.PaymentIdempotencyKey == refund.PaymentIdempotencyKey && r.Amount == refund.Amount
+}
+
+// PostRefundsHandler 成功した決済の全額もしくは一部を返金する
+// 決済と違って不安定なエラーは再現しないが、同じIdempotency-Keyのリクエストは一度しか返金しない
+func (s *Server) PostRefundsHandler(w http.ResponseWriter, r *http.Request) {
+	idk := r.Header.Get(IdempotencyKeyHeader)
+	if len(idk) == 0 {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"message": "Idempotency-Key headerがセットされていません"})
+		return
+	}
+
+	token, err := getTokenFromAuthorizationHeader(r)
+	if err != nil {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"message": err.Error()})
+		return
+	}
+
+	var req PostRefundRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"message": "不正なリクエスト形式です"})
+		return
+	}
+
+	time.Sleep(s.processTime)
+
+	s.refundMu.Lock()
+	defer s.refundMu.Unlock()
+
+	if refund, ok := s.knownRefundKeys.Get(idk); ok {
+		if !req.IsSamePayload(token, refund) {
+			writeJSON(w, http.StatusUnprocessableEntity, map[string]string{"message": "リクエストペイロードがサーバーに記録されているものと異なります"})
+			return
+		}
+		writeRefundResponse(w, refund.Status)
+		return
+	}
+
+	p, ok := s.knownKeys.Get(req.PaymentIdempotencyKey)
+	if !ok || p.Token != token {
+		writeJSON(w, http.StatusNotFound, map[string]string{"message": "返金する決済が見つかりません"})
+		return
+	}
+	if p.locked.Load() || p.Status.Type == StatusInitial {
+		writeJSON(w, http.StatusConflict, map[string]string{"message": "返金する決済が処理中です"})
+		return
+	}
+
+	refund := &Refund{
+		IdempotencyKey:        idk,
+		PaymentIdempotencyKey: req.PaymentIdempotencyKey,
+		Token:                 token,
+		Amount:                req.Amount,
+		Status:                Status{Type: StatusInvalidAmount, Err: nil},
+	}
+	if p.Refundable(req.Amount) {
+		p.refundedAmount.Add(int64(req.Amount))
+		refund.Status = Status{Type: StatusSuccess, Err: nil}
+	}
+	s.knownRefundKeys.Set(idk, refund)
+	writeRefundResponse(w, refund.Status)
+}
+
+func writeRefundResponse(w http.ResponseWriter, refundStatus Status) {
+	switch refundStatus.Type {
+	case StatusSuccess:
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeJSON(w, http.StatusBadRequest, map[string]string{"message": "返金額が不正です"})
+	}
+}
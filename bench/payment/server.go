@@ -2,6 +2,7 @@ package payment
 
 import (
 	"net/http"
+	"sync"
 	"time"
 
 	"github.com/isucon/isucon14/bench/internal/concurrent"
@@ -21,10 +22,13 @@ type Server struct {
 	knownKeys         *concurrent.SimpleMap[string, *Payment]
 	retryCounts       *concurrent.SimpleMap[string, int]
 	processedPayments *concurrent.SimpleSlice[*processedPayment]
-	processTime       time.Duration
-	verifier          Verifier
-	errChan           chan error
-	closed            bool
+	knownRefundKeys   *concurrent.SimpleMap[string, *Refund]
+	// refundMu 同じ決済の返金が同時に処理されて決済額を超えないようにする
+	refundMu    sync.Mutex
+	processTime time.Duration
+	verifier    Verifier
+	errChan     chan error
+	closed      bool
 }
 
 func NewServer(verifier Verifier, processTime time.Duration, errChan chan error) *Server {
@@ -33,12 +37,14 @@ func NewServer(verifier Verifier, processTime time.Duration, errChan chan error)
 		knownKeys:         concurrent.NewSimpleMap[string, *Payment](),
 		retryCounts:       concurrent.NewSimpleMap[string, int](),
 		processedPayments: concurrent.NewSimpleSlice[*processedPayment](),
+		knownRefundKeys:   concurrent.NewSimpleMap[string, *Refund](),
 		processTime:       processTime,
 		verifier:          verifier,
 		errChan:           errChan,
 	}
 	s.mux.HandleFunc("GET /payments", s.GetPaymentsHandler)
 	s.mux.HandleFunc("POST /payments", s.PostPaymentsHandler)
+	s.mux.HandleFunc("POST /refunds", s.PostRefundsHandler)
 	return s
 }
 
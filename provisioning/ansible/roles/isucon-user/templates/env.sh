@@ -4,5 +4,26 @@ ISUCON_DB_USER="isucon"
 ISUCON_DB_PASSWORD="isucon"
 ISUCON_DB_NAME="isuride"
 
-# マッチング間隔（秒）
+# マッチング間隔（秒）。0の場合はアプリケーション内でのマッチングを行わない
 ISUCON_MATCHING_INTERVAL=0.5
+
+# マッチング戦略 (nearest, batch)
+ISUCON_MATCHING_STRATEGY=nearest
+
+# 予約したライドのマッチングを配車日時の何秒前から始めるか
+ISUCON_SCHEDULED_RIDE_LEAD_TIME=3
+
+# この秒数より古い椅子の位置情報を間引く。0の場合は間引かない
+ISUCON_LOCATION_RETENTION=86400
+
+# 椅子の位置情報を間引く間隔（秒）
+ISUCON_LOCATION_COMPACTION_INTERVAL=600
+
+# 椅子による評価の平均がこれ未満のユーザーのライドは、マッチングで後回しにする。0の場合は後回しにしない
+ISUCON_LOW_RATED_RIDER_THRESHOLD=0
+
+# 評価のレスポンスを返した後に、決済をバックグラウンドで送るかどうか。ベンチマーカーでは --features async-payment が必要
+ISUCON_ASYNC_PAYMENT=false
+
+# 配車位置の周辺の需要と供給に応じて運賃を割り増すかどうか。ベンチマーカーでは --features surge が必要
+ISUCON_SURGE_PRICING=false
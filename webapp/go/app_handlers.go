@@ -1,10 +1,12 @@
 package main
 
 import (
+	"cmp"
 	"context"
 	"database/sql"
 	"errors"
 	"net/http"
+	"slices"
 	"strconv"
 	"time"
 
@@ -58,31 +60,21 @@ func appPostUsers(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// 初回登録キャンペーンのクーポンを付与
-	_, err = tx.ExecContext(
-		ctx,
-		"INSERT INTO coupons (user_id, code, discount) VALUES (?, ?, ?)",
-		userID, "CP_NEW2024", 3000,
-	)
+	// 登録時のキャンペーンのクーポンを付与
+	signupCampaign, err := getActiveCouponCampaign(ctx, tx, couponCampaignKindSignup)
 	if err != nil {
 		writeError(w, http.StatusInternalServerError, err)
 		return
 	}
-
-	// 招待コードを使った登録
-	if req.InvitationCode != nil && *req.InvitationCode != "" {
-		// 招待する側の招待数をチェック
-		var coupons []Coupon
-		err = tx.SelectContext(ctx, &coupons, "SELECT * FROM coupons WHERE code = ? FOR UPDATE", "INV_"+*req.InvitationCode)
-		if err != nil {
+	if signupCampaign != nil {
+		if err := issueCoupon(ctx, tx, signupCampaign, userID, signupCampaign.CodePrefix, false); err != nil && !errors.Is(err, errCouponLimitExceeded) {
 			writeError(w, http.StatusInternalServerError, err)
 			return
 		}
-		if len(coupons) >= 3 {
-			writeError(w, http.StatusBadRequest, errors.New("この招待コードは使用できません。"))
-			return
-		}
+	}
 
+	// 招待コードを使った登録
+	if req.InvitationCode != nil && *req.InvitationCode != "" {
 		// ユーザーチェック
 		var inviter User
 		err = tx.GetContext(ctx, &inviter, "SELECT * FROM users WHERE invitation_code = ?", *req.InvitationCode)
@@ -95,26 +87,39 @@ func appPostUsers(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 
-		// 招待クーポン付与
-		_, err = tx.ExecContext(
-			ctx,
-			"INSERT INTO coupons (user_id, code, discount) VALUES (?, ?, ?)",
-			userID, "INV_"+*req.InvitationCode, 1500,
-		)
+		// 招待した人にもRewardを付与。招待した人が受け取れる上限に達していれば、この招待コードは使えない
+		inviterCampaign, err := getActiveCouponCampaign(ctx, tx, couponCampaignKindInviter)
 		if err != nil {
 			writeError(w, http.StatusInternalServerError, err)
 			return
 		}
-		// 招待した人にもRewardを付与
-		_, err = tx.ExecContext(
-			ctx,
-			"INSERT INTO coupons (user_id, code, discount) VALUES (?, CONCAT(?, '_', FLOOR(UNIX_TIMESTAMP(NOW(3))*1000)), ?)",
-			inviter.ID, "RWD_"+*req.InvitationCode, 1000,
-		)
+		if inviterCampaign != nil {
+			if err := issueCoupon(ctx, tx, inviterCampaign, inviter.ID, inviterCampaign.CodePrefix+*req.InvitationCode, true); err != nil {
+				if errors.Is(err, errCouponLimitExceeded) {
+					writeError(w, http.StatusBadRequest, errors.New("この招待コードは使用できません。"))
+					return
+				}
+				writeError(w, http.StatusInternalServerError, err)
+				return
+			}
+		}
+
+		// 招待クーポン付与
+		inviteeCampaign, err := getActiveCouponCampaign(ctx, tx, couponCampaignKindInvitee)
 		if err != nil {
 			writeError(w, http.StatusInternalServerError, err)
 			return
 		}
+		if inviteeCampaign != nil {
+			if err := issueCoupon(ctx, tx, inviteeCampaign, userID, inviteeCampaign.CodePrefix+*req.InvitationCode, false); err != nil {
+				if errors.Is(err, errCouponLimitExceeded) {
+					writeError(w, http.StatusBadRequest, errors.New("この招待コードは使用できません。"))
+					return
+				}
+				writeError(w, http.StatusInternalServerError, err)
+				return
+			}
+		}
 	}
 
 	if err := tx.Commit(); err != nil {
@@ -134,40 +139,79 @@ func appPostUsers(w http.ResponseWriter, r *http.Request) {
 	})
 }
 
-type appPostPaymentMethodsRequest struct {
-	Token string `json:"token"`
+type appPostCouponsRequest struct {
+	Code string `json:"code"`
 }
 
-func appPostPaymentMethods(w http.ResponseWriter, r *http.Request) {
+type appPostCouponsResponse struct {
+	Code               string `json:"code"`
+	DiscountAmount     *int   `json:"discount_amount,omitempty"`
+	DiscountPercentage *int   `json:"discount_percentage,omitempty"`
+}
+
+// appPostCoupons ユーザーが入力したコードのキャンペーンのクーポンを受け取る
+func appPostCoupons(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
-	req := &appPostPaymentMethodsRequest{}
+	req := &appPostCouponsRequest{}
 	if err := bindJSON(r, req); err != nil {
 		writeError(w, http.StatusBadRequest, err)
 		return
 	}
-	if req.Token == "" {
-		writeError(w, http.StatusBadRequest, errors.New("token is required but was empty"))
+	if req.Code == "" {
+		writeError(w, http.StatusBadRequest, errors.New("code is required but was empty"))
 		return
 	}
 
 	user := ctx.Value("user").(*User)
 
-	_, err := db.ExecContext(
-		ctx,
-		`INSERT INTO payment_tokens (user_id, token) VALUES (?, ?)`,
-		user.ID,
-		req.Token,
-	)
+	tx, err := db.Beginx()
 	if err != nil {
 		writeError(w, http.StatusInternalServerError, err)
 		return
 	}
+	defer tx.Rollback()
+
+	// 入力するコードはキャンペーンのコードの接頭辞そのもの
+	campaign := &CouponCampaign{}
+	if err := tx.GetContext(ctx, campaign, `SELECT *
+FROM coupon_campaigns
+WHERE kind = ?
+  AND code_prefix = ?
+  AND (starts_at IS NULL OR starts_at <= CURRENT_TIMESTAMP(6))
+  AND (ends_at IS NULL OR ends_at > CURRENT_TIMESTAMP(6))`, couponCampaignKindCode, req.Code); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			writeError(w, http.StatusNotFound, errors.New("coupon code not found"))
+			return
+		}
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	if err := issueCoupon(ctx, tx, campaign, user.ID, campaign.CodePrefix, true); err != nil {
+		if errors.Is(err, errCouponLimitExceeded) {
+			writeError(w, http.StatusConflict, errors.New("このクーポンコードは使用できません。"))
+			return
+		}
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	if err := tx.Commit(); err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
 
-	w.WriteHeader(http.StatusNoContent)
+	writeJSON(w, http.StatusCreated, &appPostCouponsResponse{
+		Code:               campaign.CodePrefix,
+		DiscountAmount:     campaign.DiscountAmount,
+		DiscountPercentage: campaign.DiscountPercentage,
+	})
 }
 
 type getAppRidesResponse struct {
 	Rides []getAppRidesResponseItem `json:"rides"`
+	// ScheduledRides マッチングを始めるのを待っている予約済みのライド。無ければ含めない
+	ScheduledRides []getAppRidesResponseScheduledItem `json:"scheduled_rides,omitempty"`
 }
 
 type getAppRidesResponseItem struct {
@@ -176,7 +220,9 @@ type getAppRidesResponseItem struct {
 	DestinationCoordinate Coordinate                   `json:"destination_coordinate"`
 	Chair                 getAppRidesResponseItemChair `json:"chair"`
 	Fare                  int                          `json:"fare"`
+	Tip                   int                          `json:"tip"`
 	Evaluation            int                          `json:"evaluation"`
+	ScheduledAt           *int64                       `json:"scheduled_at,omitempty"`
 	RequestedAt           int64                        `json:"requested_at"`
 	CompletedAt           int64                        `json:"completed_at"`
 }
@@ -188,6 +234,15 @@ type getAppRidesResponseItemChair struct {
 	Model string `json:"model"`
 }
 
+type getAppRidesResponseScheduledItem struct {
+	ID                    string     `json:"id"`
+	PickupCoordinate      Coordinate `json:"pickup_coordinate"`
+	DestinationCoordinate Coordinate `json:"destination_coordinate"`
+	Fare                  int        `json:"fare"`
+	ScheduledAt           int64      `json:"scheduled_at"`
+	RequestedAt           int64      `json:"requested_at"`
+}
+
 func appGetRides(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	user := ctx.Value("user").(*User)
@@ -211,28 +266,36 @@ func appGetRides(w http.ResponseWriter, r *http.Request) {
 	}
 
 	items := []getAppRidesResponseItem{}
+	scheduledItems := []getAppRidesResponseScheduledItem{}
 	for _, ride := range rides {
 		status, err := getLatestRideStatus(ctx, tx, ride.ID)
 		if err != nil {
 			writeError(w, http.StatusInternalServerError, err)
 			return
 		}
-		if status != "COMPLETED" {
+		if status == "SCHEDULED" {
+			scheduledItems = append(scheduledItems, getAppRidesResponseScheduledItem{
+				ID:                    ride.ID,
+				PickupCoordinate:      Coordinate{Latitude: ride.PickupLatitude, Longitude: ride.PickupLongitude},
+				DestinationCoordinate: Coordinate{Latitude: ride.DestinationLatitude, Longitude: ride.DestinationLongitude},
+				Fare:                  ride.Fare,
+				ScheduledAt:           ride.ScheduledAt.UnixMilli(),
+				RequestedAt:           ride.CreatedAt.UnixMilli(),
+			})
 			continue
 		}
-
-		fare, err := calculateDiscountedFare(ctx, tx, user.ID, &ride, ride.PickupLatitude, ride.PickupLongitude, ride.DestinationLatitude, ride.DestinationLongitude)
-		if err != nil {
-			writeError(w, http.StatusInternalServerError, err)
-			return
+		if status != "COMPLETED" {
+			continue
 		}
 
 		item := getAppRidesResponseItem{
 			ID:                    ride.ID,
 			PickupCoordinate:      Coordinate{Latitude: ride.PickupLatitude, Longitude: ride.PickupLongitude},
 			DestinationCoordinate: Coordinate{Latitude: ride.DestinationLatitude, Longitude: ride.DestinationLongitude},
-			Fare:                  fare,
+			Fare:                  ride.Fare,
+			Tip:                   ride.Tip,
 			Evaluation:            *ride.Evaluation,
+			ScheduledAt:           scheduledAtMilli(&ride),
 			RequestedAt:           ride.CreatedAt.UnixMilli(),
 			CompletedAt:           ride.UpdatedAt.UnixMilli(),
 		}
@@ -263,19 +326,33 @@ func appGetRides(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// 予約済みのライドは、配車日時が近いものから並べる
+	slices.SortFunc(scheduledItems, func(a, b getAppRidesResponseScheduledItem) int { return cmp.Compare(a.ScheduledAt, b.ScheduledAt) })
+
 	writeJSON(w, http.StatusOK, &getAppRidesResponse{
-		Rides: items,
+		Rides:          items,
+		ScheduledRides: scheduledItems,
 	})
 }
 
 type appPostRidesRequest struct {
 	PickupCoordinate      *Coordinate `json:"pickup_coordinate"`
 	DestinationCoordinate *Coordinate `json:"destination_coordinate"`
+	// Stops 配車位置から目的地までに順に経由する位置
+	Stops []Coordinate `json:"stops"`
+	// Pooled 相乗りを希望するかどうか
+	Pooled bool `json:"pooled"`
+	// ScheduledAt 配車日時を予約する場合に指定する (UNIXミリ秒)
+	ScheduledAt *int64 `json:"scheduled_at"`
+	// PaymentMethodID 支払いに使う決済トークンのID。指定が無ければデフォルトの決済トークンを使う
+	PaymentMethodID *string `json:"payment_method_id"`
 }
 
 type appPostRidesResponse struct {
-	RideID string `json:"ride_id"`
-	Fare   int    `json:"fare"`
+	RideID      string `json:"ride_id"`
+	Fare        int    `json:"fare"`
+	SurgeRate   int    `json:"surge_rate"`
+	ScheduledAt *int64 `json:"scheduled_at,omitempty"`
 }
 
 type executableGet interface {
@@ -291,6 +368,11 @@ func getLatestRideStatus(ctx context.Context, tx executableGet, rideID string) (
 	return status, nil
 }
 
+// isRideFinished ライドが完了もしくはキャンセルされて、それ以上状態が変わらないかどうか
+func isRideFinished(status string) bool {
+	return status == "COMPLETED" || status == "CANCELED"
+}
+
 func appPostRides(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	req := &appPostRidesRequest{}
@@ -302,6 +384,25 @@ func appPostRides(w http.ResponseWriter, r *http.Request) {
 		writeError(w, http.StatusBadRequest, errors.New("required fields(pickup_coordinate, destination_coordinate) are empty"))
 		return
 	}
+	if err := validateRideStops(*req.PickupCoordinate, req.Stops, *req.DestinationCoordinate); err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	if req.Pooled && len(req.Stops) > 0 {
+		writeError(w, http.StatusBadRequest, errPooledRideWithStops)
+		return
+	}
+
+	now := time.Now()
+	var scheduledAt *time.Time
+	if req.ScheduledAt != nil {
+		t := time.UnixMilli(*req.ScheduledAt)
+		if !t.After(now) {
+			writeError(w, http.StatusBadRequest, errors.New("scheduled_at must be in the future"))
+			return
+		}
+		scheduledAt = &t
+	}
 
 	user := ctx.Value("user").(*User)
 	rideID := ulid.Make().String()
@@ -326,7 +427,7 @@ func appPostRides(w http.ResponseWriter, r *http.Request) {
 			writeError(w, http.StatusInternalServerError, err)
 			return
 		}
-		if status != "COMPLETED" {
+		if !isRideFinished(status) {
 			continuingRideCount++
 		}
 	}
@@ -336,116 +437,107 @@ func appPostRides(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if _, err := tx.ExecContext(
-		ctx,
-		`INSERT INTO rides (id, user_id, pickup_latitude, pickup_longitude, destination_latitude, destination_longitude)
-				  VALUES (?, ?, ?, ?, ?, ?)`,
-		rideID, user.ID, req.PickupCoordinate.Latitude, req.PickupCoordinate.Longitude, req.DestinationCoordinate.Latitude, req.DestinationCoordinate.Longitude,
-	); err != nil {
+	// 決済トークンが登録されていなければ、完了もしくはキャンセルした時のデフォルトの決済トークンで支払う
+	var paymentToken *PaymentToken
+	if req.PaymentMethodID != nil {
+		paymentToken, err = getUserPaymentToken(ctx, tx, user.ID, *req.PaymentMethodID, false)
+		if errors.Is(err, errPaymentTokenNotFound) {
+			writeError(w, http.StatusBadRequest, err)
+			return
+		}
+	} else {
+		paymentToken, err = getDefaultPaymentToken(ctx, tx, user.ID)
+	}
+	if err != nil {
 		writeError(w, http.StatusInternalServerError, err)
 		return
 	}
+	paymentTokenID := sql.NullString{}
+	if paymentToken != nil {
+		paymentTokenID = sql.NullString{String: paymentToken.ID, Valid: true}
+	}
 
-	if _, err := tx.ExecContext(
-		ctx,
-		`INSERT INTO ride_statuses (id, ride_id, status) VALUES (?, ?, ?)`,
-		ulid.Make().String(), rideID, "MATCHING",
-	); err != nil {
+	// 作成時点の需要と供給からサージ倍率を確定させ、以降の料金計算では常にこの倍率を使う
+	surgeRate, err := calculateSurgeRate(ctx, tx, req.PickupCoordinate.Latitude, req.PickupCoordinate.Longitude)
+	if err != nil {
 		writeError(w, http.StatusInternalServerError, err)
 		return
 	}
 
-	var rideCount int
-	if err := tx.GetContext(ctx, &rideCount, `SELECT COUNT(*) FROM rides WHERE user_id = ? `, user.ID); err != nil {
+	// キャンペーンの優先度が高いクーポンから使う
+	coupon, err := findUsableCoupon(ctx, tx, user.ID, true)
+	if err != nil {
 		writeError(w, http.StatusInternalServerError, err)
 		return
 	}
-
-	var coupon Coupon
-	if rideCount == 1 {
-		// 初回利用で、初回利用クーポンがあれば必ず使う
-		if err := tx.GetContext(ctx, &coupon, "SELECT * FROM coupons WHERE user_id = ? AND code = 'CP_NEW2024' AND used_by IS NULL FOR UPDATE", user.ID); err != nil {
-			if !errors.Is(err, sql.ErrNoRows) {
-				writeError(w, http.StatusInternalServerError, err)
-				return
-			}
-
-			// 無ければ他のクーポンを付与された順番に使う
-			if err := tx.GetContext(ctx, &coupon, "SELECT * FROM coupons WHERE user_id = ? AND used_by IS NULL ORDER BY created_at LIMIT 1 FOR UPDATE", user.ID); err != nil {
-				if !errors.Is(err, sql.ErrNoRows) {
-					writeError(w, http.StatusInternalServerError, err)
-					return
-				}
-			} else {
-				if _, err := tx.ExecContext(
-					ctx,
-					"UPDATE coupons SET used_by = ? WHERE user_id = ? AND code = ?",
-					rideID, user.ID, coupon.Code,
-				); err != nil {
-					writeError(w, http.StatusInternalServerError, err)
-					return
-				}
-			}
-		} else {
-			if _, err := tx.ExecContext(
-				ctx,
-				"UPDATE coupons SET used_by = ? WHERE user_id = ? AND code = 'CP_NEW2024'",
-				rideID, user.ID,
-			); err != nil {
-				writeError(w, http.StatusInternalServerError, err)
-				return
-			}
-		}
-	} else {
-		// 他のクーポンを付与された順番に使う
-		if err := tx.GetContext(ctx, &coupon, "SELECT * FROM coupons WHERE user_id = ? AND used_by IS NULL ORDER BY created_at LIMIT 1 FOR UPDATE", user.ID); err != nil {
-			if !errors.Is(err, sql.ErrNoRows) {
-				writeError(w, http.StatusInternalServerError, err)
-				return
-			}
-		} else {
-			if _, err := tx.ExecContext(
-				ctx,
-				"UPDATE coupons SET used_by = ? WHERE user_id = ? AND code = ?",
-				rideID, user.ID, coupon.Code,
-			); err != nil {
-				writeError(w, http.StatusInternalServerError, err)
-				return
-			}
+	if coupon != nil {
+		if _, err := tx.ExecContext(
+			ctx,
+			"UPDATE coupons SET used_by = ? WHERE user_id = ? AND code = ?",
+			rideID, user.ID, coupon.Code,
+		); err != nil {
+			writeError(w, http.StatusInternalServerError, err)
+			return
 		}
 	}
 
-	ride := Ride{}
-	if err := tx.GetContext(ctx, &ride, "SELECT * FROM rides WHERE id = ?", rideID); err != nil {
+	// 運賃の内訳は作成時に確定させて保存し、以降は再計算しない
+	// 経由地がある場合は、経由地を順に回る経路の距離で運賃を求める
+	fare := calculateFareBreakdown(calculateRouteDistance(*req.PickupCoordinate, req.Stops, *req.DestinationCoordinate), surgeRate, false, coupon)
+
+	if _, err := tx.ExecContext(
+		ctx,
+		`INSERT INTO rides (id, user_id, pickup_latitude, pickup_longitude, destination_latitude, destination_longitude, scheduled_at, is_pooled, surge_rate, base_fare, metered_fare, discount, fare, currency, payment_token_id)
+				  VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		rideID, user.ID, req.PickupCoordinate.Latitude, req.PickupCoordinate.Longitude, req.DestinationCoordinate.Latitude, req.DestinationCoordinate.Longitude, scheduledAt, req.Pooled, surgeRate,
+		fare.BaseFare, fare.MeteredFare, fare.Discount, fare.Fare, fare.Currency, paymentTokenID,
+	); err != nil {
 		writeError(w, http.StatusInternalServerError, err)
 		return
 	}
 
-	fare, err := calculateDiscountedFare(ctx, tx, user.ID, &ride, req.PickupCoordinate.Latitude, req.PickupCoordinate.Longitude, req.DestinationCoordinate.Latitude, req.DestinationCoordinate.Longitude)
-	if err != nil {
+	if err := insertRideStops(ctx, tx, rideID, req.Stops); err != nil {
 		writeError(w, http.StatusInternalServerError, err)
 		return
 	}
 
+	// 配車日時が先の予約は、マッチングを始める時間になるまでSCHEDULEDにしておく
+	status := "MATCHING"
+	if isScheduledForLater(scheduledAt, now) {
+		status = "SCHEDULED"
+	}
+	if _, err := transitionRide(ctx, tx, rideID, rideActorRider, status); err != nil {
+		writeRideTransitionError(w, err)
+		return
+	}
+
 	if err := tx.Commit(); err != nil {
 		writeError(w, http.StatusInternalServerError, err)
 		return
 	}
+	if status == "MATCHING" {
+		wakeMatching()
+	}
 
 	writeJSON(w, http.StatusAccepted, &appPostRidesResponse{
-		RideID: rideID,
-		Fare:   fare,
+		RideID:      rideID,
+		Fare:        fare.Fare,
+		SurgeRate:   surgeRate,
+		ScheduledAt: req.ScheduledAt,
 	})
 }
 
 type appPostRidesEstimatedFareRequest struct {
-	PickupCoordinate      *Coordinate `json:"pickup_coordinate"`
-	DestinationCoordinate *Coordinate `json:"destination_coordinate"`
+	PickupCoordinate      *Coordinate  `json:"pickup_coordinate"`
+	DestinationCoordinate *Coordinate  `json:"destination_coordinate"`
+	Stops                 []Coordinate `json:"stops"`
+	Pooled                bool         `json:"pooled"`
 }
 
 type appPostRidesEstimatedFareResponse struct {
-	Fare     int `json:"fare"`
-	Discount int `json:"discount"`
+	Fare      int `json:"fare"`
+	Discount  int `json:"discount"`
+	SurgeRate int `json:"surge_rate"`
 }
 
 func appPostRidesEstimatedFare(w http.ResponseWriter, r *http.Request) {
@@ -459,6 +551,14 @@ func appPostRidesEstimatedFare(w http.ResponseWriter, r *http.Request) {
 		writeError(w, http.StatusBadRequest, errors.New("required fields(pickup_coordinate, destination_coordinate) are empty"))
 		return
 	}
+	if err := validateRideStops(*req.PickupCoordinate, req.Stops, *req.DestinationCoordinate); err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	if req.Pooled && len(req.Stops) > 0 {
+		writeError(w, http.StatusBadRequest, errPooledRideWithStops)
+		return
+	}
 
 	user := ctx.Value("user").(*User)
 
@@ -469,7 +569,13 @@ func appPostRidesEstimatedFare(w http.ResponseWriter, r *http.Request) {
 	}
 	defer tx.Rollback()
 
-	discounted, err := calculateDiscountedFare(ctx, tx, user.ID, nil, req.PickupCoordinate.Latitude, req.PickupCoordinate.Longitude, req.DestinationCoordinate.Latitude, req.DestinationCoordinate.Longitude)
+	surgeRate, err := calculateSurgeRate(ctx, tx, req.PickupCoordinate.Latitude, req.PickupCoordinate.Longitude)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	fare, err := estimateFare(ctx, tx, user.ID, calculateRouteDistance(*req.PickupCoordinate, req.Stops, *req.DestinationCoordinate), surgeRate)
 	if err != nil {
 		writeError(w, http.StatusInternalServerError, err)
 		return
@@ -481,8 +587,9 @@ func appPostRidesEstimatedFare(w http.ResponseWriter, r *http.Request) {
 	}
 
 	writeJSON(w, http.StatusOK, &appPostRidesEstimatedFareResponse{
-		Fare:     discounted,
-		Discount: calculateFare(req.PickupCoordinate.Latitude, req.PickupCoordinate.Longitude, req.DestinationCoordinate.Latitude, req.DestinationCoordinate.Longitude) - discounted,
+		Fare:      fare.Fare,
+		Discount:  fare.Discount,
+		SurgeRate: surgeRate,
 	})
 }
 
@@ -499,6 +606,8 @@ func abs(a int) int {
 
 type appPostRideEvaluationRequest struct {
 	Evaluation int `json:"evaluation"`
+	// Tip 椅子に払うチップ。運賃とは別に決済し、クーポンは使わない
+	Tip int `json:"tip"`
 }
 
 type appPostRideEvaluationResponse struct {
@@ -518,6 +627,10 @@ func appPostRideEvaluatation(w http.ResponseWriter, r *http.Request) {
 		writeError(w, http.StatusBadRequest, errors.New("evaluation must be between 1 and 5"))
 		return
 	}
+	if req.Tip < 0 {
+		writeError(w, http.StatusBadRequest, errors.New("tip must not be negative"))
+		return
+	}
 
 	tx, err := db.Beginx()
 	if err != nil {
@@ -535,21 +648,17 @@ func appPostRideEvaluatation(w http.ResponseWriter, r *http.Request) {
 		writeError(w, http.StatusInternalServerError, err)
 		return
 	}
-	status, err := getLatestRideStatus(ctx, tx, ride.ID)
-	if err != nil {
-		writeError(w, http.StatusInternalServerError, err)
-		return
-	}
 
-	if status != "ARRIVED" {
-		writeError(w, http.StatusBadRequest, errors.New("not arrived yet"))
+	// 評価するとライドが完了する
+	if _, err := transitionRide(ctx, tx, ride.ID, rideActorRider, "COMPLETED"); err != nil {
+		writeRideTransitionError(w, err)
 		return
 	}
 
 	result, err := tx.ExecContext(
 		ctx,
-		`UPDATE rides SET evaluation = ? WHERE id = ?`,
-		req.Evaluation, rideID)
+		`UPDATE rides SET evaluation = ?, tip = ? WHERE id = ?`,
+		req.Evaluation, req.Tip, rideID)
 	if err != nil {
 		writeError(w, http.StatusInternalServerError, err)
 		return
@@ -562,16 +671,95 @@ func appPostRideEvaluatation(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	_, err = tx.ExecContext(
+	if err := tx.GetContext(ctx, ride, `SELECT * FROM rides WHERE id = ?`, rideID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			writeError(w, http.StatusNotFound, errors.New("ride not found"))
+			return
+		}
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	// 運賃はライドに記録された決済トークンで支払う
+	paymentToken, err := resolveRidePaymentToken(ctx, tx, ride)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	if paymentToken == nil {
+		writeError(w, http.StatusBadRequest, errors.New("payment token not registered"))
+		return
+	}
+
+	// 決済はコミット後に決済マイクロサービスへ送る
+	// ライドIDをIdempotency-Keyにして、リトライしても二重に決済されないようにする
+	if _, err := tx.ExecContext(
 		ctx,
-		`INSERT INTO ride_statuses (id, ride_id, status) VALUES (?, ?, ?)`,
-		ulid.Make().String(), rideID, "COMPLETED")
+		`INSERT INTO payments (id, ride_id, kind, idempotency_key, amount, status) VALUES (?, ?, 'RIDE', ?, ?, 'PENDING')`,
+		ulid.Make().String(), ride.ID, ride.ID, ride.Fare,
+	); err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	// チップは運賃とは別の決済にする。Idempotency-Keyも運賃と重ならないようにする
+	if ride.Tip > 0 {
+		if _, err := tx.ExecContext(
+			ctx,
+			`INSERT INTO payments (id, ride_id, kind, idempotency_key, amount, status) VALUES (?, ?, 'TIP', ?, ?, 'PENDING')`,
+			ulid.Make().String(), ride.ID, "tip-"+ride.ID, ride.Tip,
+		); err != nil {
+			writeError(w, http.StatusInternalServerError, err)
+			return
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	if ride.ChairID.Valid {
+		availableChairs.finishRide(ride.ChairID.String, ride.ID)
+	}
+	wakeMatching()
+	if paymentAsync {
+		wakePayment()
+	} else if err := deliverRidePayments(ctx, ride.ID); err != nil {
+		// 決済は記録してあるので、送れなかったものはバックグラウンドでリトライする
+		wakePayment()
+		if errors.Is(err, erroredUpstream) || errors.Is(err, errPaymentRejected) {
+			writeError(w, http.StatusBadGateway, err)
+			return
+		}
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, &appPostRideEvaluationResponse{
+		CompletedAt: ride.UpdatedAt.UnixMilli(),
+	})
+}
+
+type appPostRideCancelResponse struct {
+	CancellationFee int   `json:"cancellation_fee"`
+	CanceledAt      int64 `json:"canceled_at"`
+}
+
+// appPostRideCancel ユーザーがライドをキャンセルする
+// 乗車する前までキャンセルでき、椅子が配車位置へ向かい始めた後はキャンセル料がかかる
+func appPostRideCancel(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	rideID := r.PathValue("ride_id")
+	user := ctx.Value("user").(*User)
+
+	tx, err := db.Beginx()
 	if err != nil {
 		writeError(w, http.StatusInternalServerError, err)
 		return
 	}
+	defer tx.Rollback()
 
-	if err := tx.GetContext(ctx, ride, `SELECT * FROM rides WHERE id = ?`, rideID); err != nil {
+	ride := &Ride{}
+	if err := tx.GetContext(ctx, ride, `SELECT * FROM rides WHERE id = ? AND user_id = ? FOR UPDATE`, rideID, user.ID); err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			writeError(w, http.StatusNotFound, errors.New("ride not found"))
 			return
@@ -580,54 +768,271 @@ func appPostRideEvaluatation(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	paymentToken := &PaymentToken{}
-	if err := tx.GetContext(ctx, paymentToken, `SELECT * FROM payment_tokens WHERE user_id = ?`, ride.UserID); err != nil {
+	status, err := transitionRide(ctx, tx, ride.ID, rideActorRider, "CANCELED")
+	if err != nil {
+		writeRideTransitionError(w, err)
+		return
+	}
+	// 椅子が配車位置へ向かい始めた後のキャンセルには手数料がかかる
+	fee := 0
+	if status == "ENROUTE" || status == "PICKUP" {
+		fee = cancellationFee
+	}
+
+	if _, err := tx.ExecContext(ctx, `INSERT INTO ride_cancellations (ride_id, fee) VALUES (?, ?)`, ride.ID, fee); err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	// 使っていたクーポンは次のライドで使えるように戻す
+	if _, err := tx.ExecContext(ctx, `UPDATE coupons SET used_by = NULL WHERE used_by = ?`, ride.ID); err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	if fee > 0 {
+		// キャンセル料もライドに記録された決済トークンで支払う。登録されていなければ、最初に登録されるまで決済を待たせる
+		if _, err := resolveRidePaymentToken(ctx, tx, ride); err != nil {
+			writeError(w, http.StatusInternalServerError, err)
+			return
+		}
+		if _, err := tx.ExecContext(
+			ctx,
+			`INSERT INTO payments (id, ride_id, kind, idempotency_key, amount, status) VALUES (?, ?, 'RIDE', ?, ?, 'PENDING')`,
+			ulid.Make().String(), ride.ID, ride.ID, fee,
+		); err != nil {
+			writeError(w, http.StatusInternalServerError, err)
+			return
+		}
+	}
+
+	cancellation := &RideCancellation{}
+	if err := tx.GetContext(ctx, cancellation, `SELECT * FROM ride_cancellations WHERE ride_id = ?`, ride.ID); err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	if err := tx.Commit(); err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	if ride.ChairID.Valid {
+		availableChairs.finishRide(ride.ChairID.String, ride.ID)
+	}
+	if fee > 0 {
+		wakePayment()
+	}
+
+	writeJSON(w, http.StatusOK, &appPostRideCancelResponse{
+		CancellationFee: cancellation.Fee,
+		CanceledAt:      cancellation.CreatedAt.UnixMilli(),
+	})
+}
+
+type appPatchRideRequest struct {
+	PickupCoordinate      *Coordinate `json:"pickup_coordinate"`
+	DestinationCoordinate *Coordinate `json:"destination_coordinate"`
+	ScheduledAt           *int64      `json:"scheduled_at"`
+}
+
+type appPatchRideResponse struct {
+	RideID      string `json:"ride_id"`
+	Fare        int    `json:"fare"`
+	ScheduledAt *int64 `json:"scheduled_at,omitempty"`
+}
+
+// appPatchRide ユーザーが予約したライドの配車日時・配車位置・目的地を変更する
+// MATCHINGになる前の予約だけ変更でき、サージ倍率とクーポンは作成時のものを使って運賃を求め直す
+func appPatchRide(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	rideID := r.PathValue("ride_id")
+	req := &appPatchRideRequest{}
+	if err := bindJSON(r, req); err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	if req.PickupCoordinate == nil && req.DestinationCoordinate == nil && req.ScheduledAt == nil {
+		writeError(w, http.StatusBadRequest, errors.New("required fields(pickup_coordinate, destination_coordinate, scheduled_at) are empty"))
+		return
+	}
+
+	now := time.Now()
+	if req.ScheduledAt != nil && !time.UnixMilli(*req.ScheduledAt).After(now) {
+		writeError(w, http.StatusBadRequest, errors.New("scheduled_at must be in the future"))
+		return
+	}
+
+	user := ctx.Value("user").(*User)
+
+	tx, err := db.Beginx()
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	defer tx.Rollback()
+
+	ride := &Ride{}
+	if err := tx.GetContext(ctx, ride, `SELECT * FROM rides WHERE id = ? AND user_id = ? FOR UPDATE`, rideID, user.ID); err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			writeError(w, http.StatusBadRequest, errors.New("payment token not registered"))
+			writeError(w, http.StatusNotFound, errors.New("ride not found"))
 			return
 		}
 		writeError(w, http.StatusInternalServerError, err)
 		return
 	}
 
-	fare, err := calculateDiscountedFare(ctx, tx, ride.UserID, ride, ride.PickupLatitude, ride.PickupLongitude, ride.DestinationLatitude, ride.DestinationLongitude)
+	status, err := getLatestRideStatus(ctx, tx, ride.ID)
 	if err != nil {
 		writeError(w, http.StatusInternalServerError, err)
 		return
 	}
-	paymentGatewayRequest := &paymentGatewayPostPaymentRequest{
-		Amount: fare,
+	if status != "SCHEDULED" {
+		writeError(w, http.StatusBadRequest, errors.New("ride cannot be modified"))
+		return
 	}
 
-	var paymentGatewayURL string
-	if err := tx.GetContext(ctx, &paymentGatewayURL, "SELECT value FROM settings WHERE name = 'payment_gateway_url'"); err != nil {
+	if req.PickupCoordinate != nil {
+		ride.PickupLatitude = req.PickupCoordinate.Latitude
+		ride.PickupLongitude = req.PickupCoordinate.Longitude
+	}
+	if req.DestinationCoordinate != nil {
+		ride.DestinationLatitude = req.DestinationCoordinate.Latitude
+		ride.DestinationLongitude = req.DestinationCoordinate.Longitude
+	}
+	if req.ScheduledAt != nil {
+		t := time.UnixMilli(*req.ScheduledAt)
+		ride.ScheduledAt = &t
+	}
+
+	coupon, err := findRideCoupon(ctx, tx, ride.ID)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	stops, err := getRideStops(ctx, tx, ride.ID)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	if err := validateRideStops(
+		Coordinate{Latitude: ride.PickupLatitude, Longitude: ride.PickupLongitude},
+		rideStopCoordinates(stops),
+		Coordinate{Latitude: ride.DestinationLatitude, Longitude: ride.DestinationLongitude},
+	); err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	fare := calculateFareBreakdown(calculateRideRouteDistance(ride, stops), ride.SurgeRate, false, coupon)
+
+	if _, err := tx.ExecContext(
+		ctx,
+		`UPDATE rides SET pickup_latitude = ?, pickup_longitude = ?, destination_latitude = ?, destination_longitude = ?, scheduled_at = ?, metered_fare = ?, discount = ?, fare = ? WHERE id = ?`,
+		ride.PickupLatitude, ride.PickupLongitude, ride.DestinationLatitude, ride.DestinationLongitude, ride.ScheduledAt,
+		fare.MeteredFare, fare.Discount, fare.Fare, ride.ID,
+	); err != nil {
 		writeError(w, http.StatusInternalServerError, err)
 		return
 	}
 
-	if err := requestPaymentGatewayPostPayment(ctx, paymentGatewayURL, paymentToken.Token, paymentGatewayRequest, func() ([]Ride, error) {
-		rides := []Ride{}
-		if err := tx.SelectContext(ctx, &rides, `SELECT * FROM rides WHERE user_id = ? ORDER BY created_at ASC`, ride.UserID); err != nil {
-			return nil, err
+	// 配車日時を早めてマッチングを始める時間になった場合は、すぐにマッチングの対象にする
+	matching := !isScheduledForLater(ride.ScheduledAt, now)
+	if matching {
+		if _, err := transitionRide(ctx, tx, ride.ID, rideActorRider, "MATCHING"); err != nil {
+			writeRideTransitionError(w, err)
+			return
 		}
-		return rides, nil
-	}); err != nil {
-		if errors.Is(err, erroredUpstream) {
-			writeError(w, http.StatusBadGateway, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	if matching {
+		wakeMatching()
+	}
+
+	writeJSON(w, http.StatusOK, &appPatchRideResponse{
+		RideID:      ride.ID,
+		Fare:        fare.Fare,
+		ScheduledAt: scheduledAtMilli(ride),
+	})
+}
+
+type appGetRidePaymentResponse struct {
+	RideID     string                            `json:"ride_id"`
+	RideStatus string                            `json:"ride_status"`
+	Payment    *appGetRidePaymentResponsePayment `json:"payment"`
+	// Tip チップの決済。チップを払っていなければ含まれない
+	Tip *appGetRidePaymentResponsePayment `json:"tip,omitempty"`
+}
+
+type appGetRidePaymentResponsePayment struct {
+	Amount    int    `json:"amount"`
+	Status    string `json:"status"`
+	Attempts  int    `json:"attempts"`
+	UpdatedAt int64  `json:"updated_at"`
+}
+
+// appGetRidePayment ライドの状態と決済の状況を返す。評価前のライドではpaymentはnullになる
+func appGetRidePayment(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	rideID := r.PathValue("ride_id")
+	user := ctx.Value("user").(*User)
+
+	tx, err := db.Beginx()
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	defer tx.Rollback()
+
+	ride := &Ride{}
+	if err := tx.GetContext(ctx, ride, `SELECT * FROM rides WHERE id = ? AND user_id = ?`, rideID, user.ID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			writeError(w, http.StatusNotFound, errors.New("ride not found"))
 			return
 		}
 		writeError(w, http.StatusInternalServerError, err)
 		return
 	}
 
+	status, err := getLatestRideStatus(ctx, tx, ride.ID)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	res := &appGetRidePaymentResponse{
+		RideID:     ride.ID,
+		RideStatus: status,
+	}
+
+	payments := []Payment{}
+	if err := tx.SelectContext(ctx, &payments, `SELECT * FROM payments WHERE ride_id = ?`, ride.ID); err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	for _, payment := range payments {
+		p := &appGetRidePaymentResponsePayment{
+			Amount:    payment.Amount,
+			Status:    payment.Status,
+			Attempts:  payment.Attempts,
+			UpdatedAt: payment.UpdatedAt.UnixMilli(),
+		}
+		switch payment.Kind {
+		case "RIDE":
+			res.Payment = p
+		case "TIP":
+			res.Tip = p
+		}
+	}
+
 	if err := tx.Commit(); err != nil {
 		writeError(w, http.StatusInternalServerError, err)
 		return
 	}
 
-	writeJSON(w, http.StatusOK, &appPostRideEvaluationResponse{
-		CompletedAt: ride.UpdatedAt.UnixMilli(),
-	})
+	writeJSON(w, http.StatusOK, res)
 }
 
 type appGetNotificationResponse struct {
@@ -642,10 +1047,19 @@ type appGetNotificationResponseData struct {
 	Fare                  int                              `json:"fare"`
 	Status                string                           `json:"status"`
 	Chair                 *appGetNotificationResponseChair `json:"chair,omitempty"`
+	Stops                 []rideStopResponse               `json:"stops,omitempty"`
+	Pool                  *appGetNotificationResponsePool  `json:"pool,omitempty"`
+	ScheduledAt           *int64                           `json:"scheduled_at,omitempty"`
 	CreatedAt             int64                            `json:"created_at"`
 	UpdateAt              int64                            `json:"updated_at"`
 }
 
+// appGetNotificationResponsePool 相乗りを希望したライドの相乗りの状況
+type appGetNotificationResponsePool struct {
+	// CoRiders 同じ椅子に相乗りしている(これから乗る)他のユーザーの数
+	CoRiders int `json:"co_riders"`
+}
+
 type appGetNotificationResponseChair struct {
 	ID    string                               `json:"id"`
 	Name  string                               `json:"name"`
@@ -662,23 +1076,38 @@ func appGetNotification(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	user := ctx.Value("user").(*User)
 
-	tx, err := db.Beginx()
+	data, yetSentRideStatus, err := getAppNotification(ctx, user)
 	if err != nil {
 		writeError(w, http.StatusInternalServerError, err)
 		return
 	}
+	if err := markAppNotificationSent(ctx, yetSentRideStatus); err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, &appGetNotificationResponse{
+		Data:         data,
+		RetryAfterMs: 30,
+	})
+}
+
+// getAppNotification ユーザーの最新のライドについて、まだ通知していない最も古い状態を返す
+// 未通知の状態が無ければ最新の状態を返し、unsentはnilになる。ライドが無ければdataはnilになる
+// 未通知の状態は送った後にmarkAppNotificationSentで通知済みにする
+func getAppNotification(ctx context.Context, user *User) (data *appGetNotificationResponseData, unsent *RideStatus, err error) {
+	tx, err := db.Beginx()
+	if err != nil {
+		return nil, nil, err
+	}
 	defer tx.Rollback()
 
 	ride := &Ride{}
 	if err := tx.GetContext(ctx, ride, `SELECT * FROM rides WHERE user_id = ? ORDER BY created_at DESC LIMIT 1`, user.ID); err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			writeJSON(w, http.StatusOK, &appGetNotificationResponse{
-				RetryAfterMs: 30,
-			})
-			return
+			return nil, nil, nil
 		}
-		writeError(w, http.StatusInternalServerError, err)
-		return
+		return nil, nil, err
 	}
 
 	yetSentRideStatus := RideStatus{}
@@ -687,56 +1116,58 @@ func appGetNotification(w http.ResponseWriter, r *http.Request) {
 		if errors.Is(err, sql.ErrNoRows) {
 			status, err = getLatestRideStatus(ctx, tx, ride.ID)
 			if err != nil {
-				writeError(w, http.StatusInternalServerError, err)
-				return
+				return nil, nil, err
 			}
 		} else {
-			writeError(w, http.StatusInternalServerError, err)
-			return
+			return nil, nil, err
 		}
 	} else {
 		status = yetSentRideStatus.Status
 	}
 
-	fare, err := calculateDiscountedFare(ctx, tx, user.ID, ride, ride.PickupLatitude, ride.PickupLongitude, ride.DestinationLatitude, ride.DestinationLongitude)
+	stops, err := getRideStops(ctx, tx, ride.ID)
 	if err != nil {
-		writeError(w, http.StatusInternalServerError, err)
-		return
+		return nil, nil, err
 	}
 
-	response := &appGetNotificationResponse{
-		Data: &appGetNotificationResponseData{
-			RideID: ride.ID,
-			PickupCoordinate: Coordinate{
-				Latitude:  ride.PickupLatitude,
-				Longitude: ride.PickupLongitude,
-			},
-			DestinationCoordinate: Coordinate{
-				Latitude:  ride.DestinationLatitude,
-				Longitude: ride.DestinationLongitude,
-			},
-			Fare:      fare,
-			Status:    status,
-			CreatedAt: ride.CreatedAt.UnixMilli(),
-			UpdateAt:  ride.UpdatedAt.UnixMilli(),
+	data = &appGetNotificationResponseData{
+		RideID: ride.ID,
+		PickupCoordinate: Coordinate{
+			Latitude:  ride.PickupLatitude,
+			Longitude: ride.PickupLongitude,
 		},
-		RetryAfterMs: 30,
+		DestinationCoordinate: Coordinate{
+			Latitude:  ride.DestinationLatitude,
+			Longitude: ride.DestinationLongitude,
+		},
+		Fare:        ride.Fare,
+		Status:      status,
+		Stops:       rideStopsResponse(stops),
+		ScheduledAt: scheduledAtMilli(ride),
+		CreatedAt:   ride.CreatedAt.UnixMilli(),
+		UpdateAt:    ride.UpdatedAt.UnixMilli(),
+	}
+
+	if ride.IsPooled {
+		coRiders, err := countCoRiders(ctx, tx, ride)
+		if err != nil {
+			return nil, nil, err
+		}
+		data.Pool = &appGetNotificationResponsePool{CoRiders: coRiders}
 	}
 
 	if ride.ChairID.Valid {
 		chair := &Chair{}
 		if err := tx.GetContext(ctx, chair, `SELECT * FROM chairs WHERE id = ?`, ride.ChairID); err != nil {
-			writeError(w, http.StatusInternalServerError, err)
-			return
+			return nil, nil, err
 		}
 
 		stats, err := getChairStats(ctx, tx, chair.ID)
 		if err != nil {
-			writeError(w, http.StatusInternalServerError, err)
-			return
+			return nil, nil, err
 		}
 
-		response.Data.Chair = &appGetNotificationResponseChair{
+		data.Chair = &appGetNotificationResponseChair{
 			ID:    chair.ID,
 			Name:  chair.Name,
 			Model: chair.Model,
@@ -744,20 +1175,23 @@ func appGetNotification(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	if yetSentRideStatus.ID != "" {
-		_, err := tx.ExecContext(ctx, `UPDATE ride_statuses SET app_sent_at = CURRENT_TIMESTAMP(6) WHERE id = ?`, yetSentRideStatus.ID)
-		if err != nil {
-			writeError(w, http.StatusInternalServerError, err)
-			return
-		}
+	if err := tx.Commit(); err != nil {
+		return nil, nil, err
 	}
 
-	if err := tx.Commit(); err != nil {
-		writeError(w, http.StatusInternalServerError, err)
-		return
+	if yetSentRideStatus.ID == "" {
+		return data, nil, nil
 	}
+	return data, &yetSentRideStatus, nil
+}
 
-	writeJSON(w, http.StatusOK, response)
+// markAppNotificationSent ユーザーに送った状態を通知済みにする。送った状態が未通知のものでなければ何もしない
+func markAppNotificationSent(ctx context.Context, yetSentRideStatus *RideStatus) error {
+	if yetSentRideStatus == nil {
+		return nil
+	}
+	_, err := db.ExecContext(ctx, `UPDATE ride_statuses SET app_sent_at = CURRENT_TIMESTAMP(6) WHERE id = ? AND app_sent_at IS NULL`, yetSentRideStatus.ID)
+	return err
 }
 
 func getChairStats(ctx context.Context, tx *sqlx.Tx, chairID string) (appGetNotificationResponseChairStats, error) {
@@ -864,89 +1298,18 @@ func appGetNearbyChairs(w http.ResponseWriter, r *http.Request) {
 
 	coordinate := Coordinate{Latitude: lat, Longitude: lon}
 
-	tx, err := db.Beginx()
-	if err != nil {
-		writeError(w, http.StatusInternalServerError, err)
-		return
-	}
-	defer tx.Rollback()
-
-	chairs := []Chair{}
-	err = tx.SelectContext(
-		ctx,
-		&chairs,
-		`SELECT * FROM chairs`,
-	)
-	if err != nil {
-		writeError(w, http.StatusInternalServerError, err)
-		return
-	}
-
 	nearbyChairs := []appGetNearbyChairsResponseChair{}
-	for _, chair := range chairs {
-		if !chair.IsActive {
-			continue
-		}
-
-		rides := []*Ride{}
-		if err := tx.SelectContext(ctx, &rides, `SELECT * FROM rides WHERE chair_id = ? ORDER BY created_at DESC`, chair.ID); err != nil {
-			writeError(w, http.StatusInternalServerError, err)
-			return
-		}
-
-		skip := false
-		for _, ride := range rides {
-			// 過去にライドが存在し、かつ、それが完了していない場合はスキップ
-			status, err := getLatestRideStatus(ctx, tx, ride.ID)
-			if err != nil {
-				writeError(w, http.StatusInternalServerError, err)
-				return
-			}
-			if status != "COMPLETED" {
-				skip = true
-				break
-			}
-		}
-		if skip {
-			continue
-		}
-
-		// 最新の位置情報を取得
-		chairLocation := &ChairLocation{}
-		err = tx.GetContext(
-			ctx,
-			chairLocation,
-			`SELECT * FROM chair_locations WHERE chair_id = ? ORDER BY created_at DESC LIMIT 1`,
-			chair.ID,
-		)
-		if err != nil {
-			if errors.Is(err, sql.ErrNoRows) {
-				continue
-			}
-			writeError(w, http.StatusInternalServerError, err)
-			return
-		}
-
-		if calculateDistance(coordinate.Latitude, coordinate.Longitude, chairLocation.Latitude, chairLocation.Longitude) <= distance {
-			nearbyChairs = append(nearbyChairs, appGetNearbyChairsResponseChair{
-				ID:    chair.ID,
-				Name:  chair.Name,
-				Model: chair.Model,
-				CurrentCoordinate: Coordinate{
-					Latitude:  chairLocation.Latitude,
-					Longitude: chairLocation.Longitude,
-				},
-			})
-		}
+	for _, chair := range availableChairs.search(coordinate, distance) {
+		nearbyChairs = append(nearbyChairs, appGetNearbyChairsResponseChair{
+			ID:                chair.ID,
+			Name:              chair.Name,
+			Model:             chair.Model,
+			CurrentCoordinate: chair.Coordinate,
+		})
 	}
 
 	retrievedAt := &time.Time{}
-	err = tx.GetContext(
-		ctx,
-		retrievedAt,
-		`SELECT CURRENT_TIMESTAMP(6)`,
-	)
-	if err != nil {
+	if err := db.GetContext(ctx, retrievedAt, `SELECT CURRENT_TIMESTAMP(6)`); err != nil {
 		writeError(w, http.StatusInternalServerError, err)
 		return
 	}
@@ -957,50 +1320,48 @@ func appGetNearbyChairs(w http.ResponseWriter, r *http.Request) {
 	})
 }
 
-func calculateFare(pickupLatitude, pickupLongitude, destLatitude, destLongitude int) int {
-	meteredFare := farePerDistance * calculateDistance(pickupLatitude, pickupLongitude, destLatitude, destLongitude)
-	return initialFare + meteredFare
+// fareBreakdown ライドの運賃の内訳。ライドの作成時に確定させてridesテーブルに保存する
+type fareBreakdown struct {
+	// BaseFare 初乗り運賃
+	BaseFare int
+	// MeteredFare 距離に応じた運賃(サージ倍率と相乗りの割引の適用後)
+	MeteredFare int
+	// Discount 実際に割り引いた額
+	Discount int
+	// Fare ユーザーが支払う運賃
+	Fare int
+	// Currency 通貨
+	Currency string
 }
 
-func calculateDiscountedFare(ctx context.Context, tx *sqlx.Tx, userID string, ride *Ride, pickupLatitude, pickupLongitude, destLatitude, destLongitude int) (int, error) {
-	var coupon Coupon
+// calculateFareBreakdown クーポンの割引額を距離に応じた運賃から差し引いて、運賃の内訳を求める。クーポンを使わない場合はcouponにnilを渡す
+// distanceは配車位置から経由地を回って目的地に着くまでの距離
+// sharedは相乗りになったライドかどうか。相乗りを希望していても、相乗りになるまでは割り引かない
+func calculateFareBreakdown(distance, surgeRate int, shared bool, coupon *usableCoupon) fareBreakdown {
+	meteredFare := calculateMeteredFare(distance, surgeRate, shared)
 	discount := 0
-	if ride != nil {
-		destLatitude = ride.DestinationLatitude
-		destLongitude = ride.DestinationLongitude
-		pickupLatitude = ride.PickupLatitude
-		pickupLongitude = ride.PickupLongitude
-
-		// すでにクーポンが紐づいているならそれの割引額を参照
-		if err := tx.GetContext(ctx, &coupon, "SELECT * FROM coupons WHERE used_by = ?", ride.ID); err != nil {
-			if !errors.Is(err, sql.ErrNoRows) {
-				return 0, err
-			}
-		} else {
-			discount = coupon.Discount
-		}
-	} else {
-		// 初回利用クーポンを最優先で使う
-		if err := tx.GetContext(ctx, &coupon, "SELECT * FROM coupons WHERE user_id = ? AND code = 'CP_NEW2024' AND used_by IS NULL", userID); err != nil {
-			if !errors.Is(err, sql.ErrNoRows) {
-				return 0, err
-			}
-
-			// 無いなら他のクーポンを付与された順番に使う
-			if err := tx.GetContext(ctx, &coupon, "SELECT * FROM coupons WHERE user_id = ? AND used_by IS NULL ORDER BY created_at LIMIT 1", userID); err != nil {
-				if !errors.Is(err, sql.ErrNoRows) {
-					return 0, err
-				}
-			} else {
-				discount = coupon.Discount
-			}
-		} else {
-			discount = coupon.Discount
-		}
+	if coupon != nil {
+		discount = min(coupon.discountFor(meteredFare), meteredFare)
+	}
+	return fareBreakdown{
+		BaseFare:    initialFare,
+		MeteredFare: meteredFare,
+		Discount:    discount,
+		Fare:        initialFare + meteredFare - discount,
+		Currency:    fareCurrency,
 	}
+}
 
-	meteredFare := farePerDistance * calculateDistance(pickupLatitude, pickupLongitude, destLatitude, destLongitude)
-	discountedMeteredFare := max(meteredFare-discount, 0)
+// calculateMeteredFare 距離に応じた運賃にサージ倍率を掛け、相乗りの割引をしたもの。初乗り運賃には倍率を掛けない
+func calculateMeteredFare(distance, surgeRate int, shared bool) int {
+	return applyPoolDiscount(farePerDistance*distance*surgeRate/100, shared)
+}
 
-	return initialFare + discountedMeteredFare, nil
+// estimateFare 次にライドを作成した時に使われるクーポンで運賃を見積もる
+func estimateFare(ctx context.Context, tx *sqlx.Tx, userID string, distance, surgeRate int) (fareBreakdown, error) {
+	coupon, err := findUsableCoupon(ctx, tx, userID, false)
+	if err != nil {
+		return fareBreakdown{}, err
+	}
+	return calculateFareBreakdown(distance, surgeRate, false, coupon), nil
 }
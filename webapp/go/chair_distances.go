@@ -0,0 +1,58 @@
+package main
+
+import (
+	"context"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// 椅子の総移動距離は、位置情報を記録する度に前回の位置からのマンハッタン距離を足してchair_distancesに保存する
+// 総移動距離の更新日時は最後に記録した位置の登録日時で、移動していなくても更新する
+
+// addChairDistance 記録した位置情報を椅子の総移動距離に反映する。位置情報の記録と同じトランザクションで呼ぶ
+func addChairDistance(ctx context.Context, tx *sqlx.Tx, location *ChairLocation) error {
+	// 代入は左から順に行われるので、total_distanceは更新前の位置との差から求まる
+	_, err := tx.ExecContext(ctx, `INSERT INTO chair_distances (chair_id, total_distance, latitude, longitude, updated_at)
+VALUES (?, 0, ?, ?, ?) AS new
+ON DUPLICATE KEY UPDATE total_distance = total_distance + ABS(new.latitude - latitude) + ABS(new.longitude - longitude),
+                        latitude       = new.latitude,
+                        longitude      = new.longitude,
+                        updated_at     = new.updated_at`,
+		location.ChairID, location.Latitude, location.Longitude, location.CreatedAt,
+	)
+	return err
+}
+
+// backfillChairDistances 記録済みの全ての位置情報から椅子の総移動距離を求め直す
+// 古い位置情報を間引いたことで求まらなくなった移動距離は、chair_location_compactionsの記録を足す
+func backfillChairDistances(ctx context.Context) error {
+	tx, err := db.Beginx()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM chair_distances`); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, `INSERT INTO chair_distances (chair_id, total_distance, latitude, longitude, updated_at)
+SELECT totals.chair_id, totals.total_distance + IFNULL(chair_location_compactions.removed_distance, 0), latest.latitude, latest.longitude, latest.created_at
+FROM (SELECT chair_id,
+             SUM(IFNULL(distance, 0)) AS total_distance
+      FROM (SELECT chair_id,
+                   ABS(latitude - LAG(latitude) OVER (PARTITION BY chair_id ORDER BY created_at)) +
+                   ABS(longitude - LAG(longitude) OVER (PARTITION BY chair_id ORDER BY created_at)) AS distance
+            FROM chair_locations) tmp
+      GROUP BY chair_id) totals
+       INNER JOIN (SELECT chair_id,
+                          latitude,
+                          longitude,
+                          created_at,
+                          ROW_NUMBER() OVER (PARTITION BY chair_id ORDER BY created_at DESC) AS rn
+                   FROM chair_locations) latest ON latest.chair_id = totals.chair_id AND latest.rn = 1
+       LEFT JOIN chair_location_compactions ON chair_location_compactions.chair_id = totals.chair_id`); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
@@ -1,11 +1,13 @@
 package main
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"fmt"
 	"net/http"
 
+	"github.com/jmoiron/sqlx"
 	"github.com/oklog/ulid/v2"
 )
 
@@ -54,6 +56,7 @@ func chairPostChairs(w http.ResponseWriter, r *http.Request) {
 		writeError(w, http.StatusInternalServerError, err)
 		return
 	}
+	availableChairs.putChair(&Chair{ID: chairID, Name: req.Name, Model: req.Model, IsActive: false})
 
 	http.SetCookie(w, &http.Cookie{
 		Path:  "/",
@@ -80,12 +83,20 @@ func chairPostActivity(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusBadRequest)
 		return
 	}
+	if req.IsActive && chair.RetiredAt != nil {
+		writeError(w, http.StatusBadRequest, errRetiredChair)
+		return
+	}
 
 	_, err := db.ExecContext(ctx, "UPDATE chairs SET is_active = ? WHERE id = ?", req.IsActive, chair.ID)
 	if err != nil {
 		writeError(w, http.StatusInternalServerError, err)
 		return
 	}
+	availableChairs.setActive(chair.ID, req.IsActive)
+	if req.IsActive {
+		wakeMatching()
+	}
 
 	w.WriteHeader(http.StatusNoContent)
 }
@@ -127,48 +138,78 @@ func chairPostCoordinate(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	ride := &Ride{}
-	if err := tx.GetContext(ctx, ride, `SELECT * FROM rides WHERE chair_id = ? ORDER BY updated_at DESC LIMIT 1`, chair.ID); err != nil {
-		if !errors.Is(err, sql.ErrNoRows) {
-			writeError(w, http.StatusInternalServerError, err)
-			return
-		}
-	} else {
-		status, err := getLatestRideStatus(ctx, tx, ride.ID)
-		if err != nil {
+	if err := addChairDistance(ctx, tx, location); err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	// 相乗りの場合は複数のライドを運んでいるので、進行中のライドそれぞれについて到着を判定する
+	// キャンセルなどと同時に状態が変わらないように、判定する前にライドをロックしておく
+	rides := []Ride{}
+	if err := tx.SelectContext(
+		ctx,
+		&rides,
+		`SELECT * FROM rides WHERE chair_id = ? AND NOT EXISTS (SELECT 1 FROM ride_statuses WHERE ride_statuses.ride_id = rides.id AND ride_statuses.status IN ('COMPLETED', 'CANCELED')) ORDER BY created_at FOR UPDATE`,
+		chair.ID,
+	); err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	for i := range rides {
+		if err := updateRideByChairLocation(ctx, tx, &rides[i], req); err != nil {
 			writeError(w, http.StatusInternalServerError, err)
 			return
 		}
-		if status != "COMPLETED" && status != "CANCELED" {
-			if req.Latitude == ride.PickupLatitude && req.Longitude == ride.PickupLongitude && status == "ENROUTE" {
-				if _, err := tx.ExecContext(ctx, "INSERT INTO ride_statuses (id, ride_id, status) VALUES (?, ?, ?)", ulid.Make().String(), ride.ID, "PICKUP"); err != nil {
-					writeError(w, http.StatusInternalServerError, err)
-					return
-				}
-			}
-
-			if req.Latitude == ride.DestinationLatitude && req.Longitude == ride.DestinationLongitude && status == "CARRYING" {
-				if _, err := tx.ExecContext(ctx, "INSERT INTO ride_statuses (id, ride_id, status) VALUES (?, ?, ?)", ulid.Make().String(), ride.ID, "ARRIVED"); err != nil {
-					writeError(w, http.StatusInternalServerError, err)
-					return
-				}
-			}
-		}
 	}
 
 	if err := tx.Commit(); err != nil {
 		writeError(w, http.StatusInternalServerError, err)
 		return
 	}
+	availableChairs.move(chair.ID, *req)
 
 	writeJSON(w, http.StatusOK, &chairPostCoordinateResponse{
 		RecordedAt: location.CreatedAt.UnixMilli(),
 	})
 }
 
+// updateRideByChairLocation 椅子の位置から、配車位置・経由地・目的地への到着を記録する
+func updateRideByChairLocation(ctx context.Context, tx *sqlx.Tx, ride *Ride, location *Coordinate) error {
+	status, err := getLatestRideStatus(ctx, tx, ride.ID)
+	if err != nil {
+		return err
+	}
+
+	if location.Latitude == ride.PickupLatitude && location.Longitude == ride.PickupLongitude && status == "ENROUTE" {
+		if _, err := transitionRide(ctx, tx, ride.ID, rideActorSystem, "PICKUP"); err != nil {
+			return err
+		}
+	}
+
+	if isCarryingRide(status) {
+		// 経由地を順に回ってから目的地に到着する
+		stops, err := getRideStops(ctx, tx, ride.ID)
+		if err != nil {
+			return err
+		}
+		if stop := nextRideStop(stops); stop != nil {
+			if location.Latitude == stop.Latitude && location.Longitude == stop.Longitude {
+				return arriveAtRideStop(ctx, tx, stop)
+			}
+		} else if location.Latitude == ride.DestinationLatitude && location.Longitude == ride.DestinationLongitude {
+			if _, err := transitionRide(ctx, tx, ride.ID, rideActorSystem, "ARRIVED"); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
 type simpleUser struct {
 	ID   string `json:"id"`
 	Name string `json:"name"`
+	// Stats 椅子によるユーザーの評価。椅子への通知にだけ含める
+	Stats *riderStats `json:"stats,omitempty"`
 }
 
 type chairGetNotificationResponse struct {
@@ -177,92 +218,139 @@ type chairGetNotificationResponse struct {
 }
 
 type chairGetNotificationResponseData struct {
-	RideID                string     `json:"ride_id"`
-	User                  simpleUser `json:"user"`
-	PickupCoordinate      Coordinate `json:"pickup_coordinate"`
-	DestinationCoordinate Coordinate `json:"destination_coordinate"`
-	Status                string     `json:"status"`
+	RideID                string             `json:"ride_id"`
+	User                  simpleUser         `json:"user"`
+	PickupCoordinate      Coordinate         `json:"pickup_coordinate"`
+	DestinationCoordinate Coordinate         `json:"destination_coordinate"`
+	Stops                 []rideStopResponse `json:"stops,omitempty"`
+	// NextCoordinate 椅子が次に向かう位置
+	NextCoordinate Coordinate `json:"next_coordinate"`
+	// IsPooled 相乗りを希望したライドかどうか
+	IsPooled    bool   `json:"is_pooled"`
+	Status      string `json:"status"`
+	ScheduledAt *int64 `json:"scheduled_at,omitempty"`
 }
 
 func chairGetNotification(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	chair := ctx.Value("chair").(*Chair)
 
-	tx, err := db.Beginx()
+	data, yetSentRideStatus, err := getChairNotification(ctx, chair)
 	if err != nil {
 		writeError(w, http.StatusInternalServerError, err)
 		return
 	}
+	if err := markChairNotificationSent(ctx, yetSentRideStatus); err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, &chairGetNotificationResponse{
+		Data:         data,
+		RetryAfterMs: 30,
+	})
+}
+
+// getChairNotification 椅子の最新のライドについて、まだ通知していない最も古い状態を返す
+// 未通知の状態が無ければ最新の状態を返し、unsentはnilになる。ライドが無ければdataはnilになる
+// 未通知の状態は送った後にmarkChairNotificationSentで通知済みにする
+func getChairNotification(ctx context.Context, chair *Chair) (data *chairGetNotificationResponseData, unsent *RideStatus, err error) {
+	tx, err := db.Beginx()
+	if err != nil {
+		return nil, nil, err
+	}
 	defer tx.Rollback()
 	ride := &Ride{}
 	yetSentRideStatus := RideStatus{}
 	status := ""
 
-	if err := tx.GetContext(ctx, ride, `SELECT * FROM rides WHERE chair_id = ? ORDER BY updated_at DESC LIMIT 1`, chair.ID); err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			writeJSON(w, http.StatusOK, &chairGetNotificationResponse{
-				RetryAfterMs: 30,
-			})
-			return
+	// 相乗りの場合は複数のライドを運んでいるので、割り当てられているライド全体からまだ通知していない最も古い状態を探す
+	if err := tx.GetContext(ctx, &yetSentRideStatus, `SELECT ride_statuses.*
+FROM ride_statuses
+       INNER JOIN rides ON rides.id = ride_statuses.ride_id
+WHERE rides.chair_id = ?
+  AND ride_statuses.chair_sent_at IS NULL
+ORDER BY ride_statuses.created_at ASC
+LIMIT 1`, chair.ID); err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			return nil, nil, err
 		}
-		writeError(w, http.StatusInternalServerError, err)
-		return
-	}
-
-	if err := tx.GetContext(ctx, &yetSentRideStatus, `SELECT * FROM ride_statuses WHERE ride_id = ? AND chair_sent_at IS NULL ORDER BY created_at ASC LIMIT 1`, ride.ID); err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			status, err = getLatestRideStatus(ctx, tx, ride.ID)
-			if err != nil {
-				writeError(w, http.StatusInternalServerError, err)
-				return
+		if err := tx.GetContext(ctx, ride, `SELECT * FROM rides WHERE chair_id = ? ORDER BY updated_at DESC LIMIT 1`, chair.ID); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return nil, nil, nil
 			}
-		} else {
-			writeError(w, http.StatusInternalServerError, err)
-			return
+			return nil, nil, err
+		}
+		status, err = getLatestRideStatus(ctx, tx, ride.ID)
+		if err != nil {
+			return nil, nil, err
 		}
 	} else {
+		if err := tx.GetContext(ctx, ride, `SELECT * FROM rides WHERE id = ?`, yetSentRideStatus.RideID); err != nil {
+			return nil, nil, err
+		}
 		status = yetSentRideStatus.Status
 	}
 
 	user := &User{}
 	err = tx.GetContext(ctx, user, "SELECT * FROM users WHERE id = ? FOR SHARE", ride.UserID)
 	if err != nil {
-		writeError(w, http.StatusInternalServerError, err)
-		return
+		return nil, nil, err
 	}
 
-	if yetSentRideStatus.ID != "" {
-		_, err := tx.ExecContext(ctx, `UPDATE ride_statuses SET chair_sent_at = CURRENT_TIMESTAMP(6) WHERE id = ?`, yetSentRideStatus.ID)
-		if err != nil {
-			writeError(w, http.StatusInternalServerError, err)
-			return
-		}
+	stats, err := getRiderStats(ctx, tx, user.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	stops, err := getRideStops(ctx, tx, ride.ID)
+	if err != nil {
+		return nil, nil, err
 	}
 
 	if err := tx.Commit(); err != nil {
-		writeError(w, http.StatusInternalServerError, err)
-		return
+		return nil, nil, err
 	}
 
-	writeJSON(w, http.StatusOK, &chairGetNotificationResponse{
-		Data: &chairGetNotificationResponseData{
-			RideID: ride.ID,
-			User: simpleUser{
-				ID:   user.ID,
-				Name: fmt.Sprintf("%s %s", user.Firstname, user.Lastname),
-			},
-			PickupCoordinate: Coordinate{
-				Latitude:  ride.PickupLatitude,
-				Longitude: ride.PickupLongitude,
-			},
-			DestinationCoordinate: Coordinate{
-				Latitude:  ride.DestinationLatitude,
-				Longitude: ride.DestinationLongitude,
-			},
-			Status: status,
+	if yetSentRideStatus.ID != "" {
+		unsent = &yetSentRideStatus
+	}
+	return &chairGetNotificationResponseData{
+		RideID: ride.ID,
+		User: simpleUser{
+			ID:    user.ID,
+			Name:  fmt.Sprintf("%s %s", user.Firstname, user.Lastname),
+			Stats: stats,
 		},
-		RetryAfterMs: 30,
-	})
+		PickupCoordinate: Coordinate{
+			Latitude:  ride.PickupLatitude,
+			Longitude: ride.PickupLongitude,
+		},
+		DestinationCoordinate: Coordinate{
+			Latitude:  ride.DestinationLatitude,
+			Longitude: ride.DestinationLongitude,
+		},
+		Stops:          rideStopsResponse(stops),
+		NextCoordinate: nextRideTarget(ride, status, stops),
+		IsPooled:       ride.IsPooled,
+		Status:         status,
+		ScheduledAt:    scheduledAtMilli(ride),
+	}, unsent, nil
+}
+
+// markChairNotificationSent 椅子に送った状態を通知済みにする。送った状態が未通知のものでなければ何もしない
+func markChairNotificationSent(ctx context.Context, yetSentRideStatus *RideStatus) error {
+	if yetSentRideStatus == nil {
+		return nil
+	}
+	if _, err := db.ExecContext(ctx, `UPDATE ride_statuses SET chair_sent_at = CURRENT_TIMESTAMP(6) WHERE id = ? AND chair_sent_at IS NULL`, yetSentRideStatus.ID); err != nil {
+		return err
+	}
+	if isRideFinished(yetSentRideStatus.Status) {
+		// 完了もしくはキャンセルの通知が届いた時点で椅子が空く
+		wakeMatching()
+	}
+	return nil
 }
 
 type postChairRidesRideIDStatusRequest struct {
@@ -303,36 +391,69 @@ func chairPostRideStatus(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	status, err := getLatestRideStatus(ctx, tx, ride.ID)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	// 断るのは引き受ける前、辞退するのは引き受けた後に限る。それ以外の遷移はtransitionRideで確認する
 	switch req.Status {
 	// Acknowledge the ride
 	case "ENROUTE":
-		if _, err := tx.ExecContext(ctx, "INSERT INTO ride_statuses (id, ride_id, status) VALUES (?, ?, ?)", ulid.Make().String(), ride.ID, "ENROUTE"); err != nil {
-			writeError(w, http.StatusInternalServerError, err)
-			return
-		}
+		_, err = transitionRide(ctx, tx, ride.ID, rideActorChair, "ENROUTE")
 	// After Picking up user
 	case "CARRYING":
-		status, err := getLatestRideStatus(ctx, tx, ride.ID)
-		if err != nil {
-			writeError(w, http.StatusInternalServerError, err)
-			return
-		}
-		if status != "PICKUP" {
-			writeError(w, http.StatusBadRequest, errors.New("chair has not arrived yet"))
-			return
+		_, err = transitionRide(ctx, tx, ride.ID, rideActorChair, "CARRYING")
+	// Reject the matched ride before acknowledging it
+	case "REJECT":
+		if status != "MATCHING" {
+			err = fmt.Errorf("%w: chair cannot reject ride in %s", errIllegalRideTransition, status)
+		} else {
+			err = returnRideToMatching(ctx, tx, ride.ID, chair.ID)
 		}
-		if _, err := tx.ExecContext(ctx, "INSERT INTO ride_statuses (id, ride_id, status) VALUES (?, ?, ?)", ulid.Make().String(), ride.ID, "CARRYING"); err != nil {
-			writeError(w, http.StatusInternalServerError, err)
-			return
+	// Decline the acknowledged ride and send it back to matching
+	case "MATCHING":
+		if status == "MATCHING" {
+			err = fmt.Errorf("%w: chair cannot decline ride in %s", errIllegalRideTransition, status)
+		} else {
+			err = returnRideToMatching(ctx, tx, ride.ID, chair.ID)
 		}
 	default:
 		writeError(w, http.StatusBadRequest, errors.New("invalid status"))
+		return
+	}
+	if err != nil {
+		writeRideTransitionError(w, err)
+		return
 	}
 
 	if err := tx.Commit(); err != nil {
 		writeError(w, http.StatusInternalServerError, err)
 		return
 	}
+	// 引き受けなかったライドは椅子から外れる
+	if req.Status == "REJECT" || req.Status == "MATCHING" {
+		availableChairs.finishRide(chair.ID, ride.ID)
+	}
+	wakeMatching()
 
 	w.WriteHeader(http.StatusNoContent)
 }
+
+// returnRideToMatching 椅子が引き受けなかったライドをマッチング待ちに戻す
+// 同じ椅子に再び割り当てないように記録し、ユーザーにはマッチングからやり直すことを通知する
+func returnRideToMatching(ctx context.Context, tx *sqlx.Tx, rideID, chairID string) error {
+	if _, err := tx.ExecContext(ctx, "UPDATE rides SET chair_id = NULL WHERE id = ?", rideID); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, "INSERT INTO ride_rejections (ride_id, chair_id) VALUES (?, ?)", rideID, chairID); err != nil {
+		return err
+	}
+	// 引き受けなかった椅子にまだ届いていない通知は、次に割り当てられる椅子には送らない
+	if _, err := tx.ExecContext(ctx, "UPDATE ride_statuses SET chair_sent_at = CURRENT_TIMESTAMP(6) WHERE ride_id = ? AND chair_sent_at IS NULL", rideID); err != nil {
+		return err
+	}
+	_, err := transitionRide(ctx, tx, rideID, rideActorChair, "MATCHING")
+	return err
+}
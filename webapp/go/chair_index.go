@@ -0,0 +1,248 @@
+package main
+
+import (
+	"context"
+	"sync"
+)
+
+// 周辺の椅子の検索に答えるために、空いている椅子を最新の位置でグリッドに分けてメモリ上に持っておく
+// 空いている椅子は、稼働中で引退しておらず、位置情報があり、終わっていない(最新の状態がCOMPLETEDでもCANCELEDでもない)ライドを持たない椅子
+// 位置情報の記録・稼働状態の変更・ライドの割り当てと完了・キャンセルのコミット後に更新し、起動時と初期化時にDBから作り直す
+
+// chairIndexCellSize グリッドの1マスの大きさ
+const chairIndexCellSize = 50
+
+type chairIndexCell struct {
+	latitude  int
+	longitude int
+}
+
+func chairIndexCellOf(latitude, longitude int) chairIndexCell {
+	return chairIndexCell{
+		latitude:  floorDiv(latitude, chairIndexCellSize),
+		longitude: floorDiv(longitude, chairIndexCellSize),
+	}
+}
+
+// floorDiv 負の数でも小さい方に丸める割り算
+func floorDiv(a, b int) int {
+	q := a / b
+	if a%b != 0 && (a < 0) != (b < 0) {
+		q--
+	}
+	return q
+}
+
+type indexedChair struct {
+	ID         string
+	Name       string
+	Model      string
+	Coordinate Coordinate
+
+	active      bool
+	hasLocation bool
+	// rides 椅子に割り当てられていて、まだ終わっていないライドのID
+	rides map[string]bool
+	// cell 空いている椅子として登録されているマス。登録されていなければnil
+	cell *chairIndexCell
+}
+
+func (c *indexedChair) isAvailable() bool {
+	return c.active && c.hasLocation && len(c.rides) == 0
+}
+
+type chairIndex struct {
+	mu     sync.RWMutex
+	chairs map[string]*indexedChair
+	cells  map[chairIndexCell]map[string]*indexedChair
+	// finishedRides 割り当てより先に終わったことが反映されたライドのID
+	// マッチングとキャンセルが同時に行われた時に、終わったライドを割り当て直さないようにする
+	finishedRides map[string]bool
+}
+
+var availableChairs = &chairIndex{}
+
+// reset 椅子とそのライドで索引を作り直す
+func (idx *chairIndex) reset(chairs []*indexedChair) {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+	idx.chairs = map[string]*indexedChair{}
+	idx.cells = map[chairIndexCell]map[string]*indexedChair{}
+	idx.finishedRides = map[string]bool{}
+	for _, c := range chairs {
+		if c.rides == nil {
+			c.rides = map[string]bool{}
+		}
+		c.cell = nil
+		idx.chairs[c.ID] = c
+		idx.reindex(c)
+	}
+}
+
+// reindex 椅子が空いていれば今の位置のマスに登録し、空いていなければ登録を外す。ロックを取ってから呼ぶ
+func (idx *chairIndex) reindex(c *indexedChair) {
+	if c.cell != nil {
+		delete(idx.cells[*c.cell], c.ID)
+		if len(idx.cells[*c.cell]) == 0 {
+			delete(idx.cells, *c.cell)
+		}
+		c.cell = nil
+	}
+	if !c.isAvailable() {
+		return
+	}
+	cell := chairIndexCellOf(c.Coordinate.Latitude, c.Coordinate.Longitude)
+	if idx.cells[cell] == nil {
+		idx.cells[cell] = map[string]*indexedChair{}
+	}
+	idx.cells[cell][c.ID] = c
+	c.cell = &cell
+}
+
+// update 椅子を変更して索引に反映する。索引に無い椅子は無視する
+func (idx *chairIndex) update(chairID string, f func(c *indexedChair)) {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+	c, ok := idx.chairs[chairID]
+	if !ok {
+		return
+	}
+	f(c)
+	idx.reindex(c)
+}
+
+// putChair 椅子を登録し、既に登録されていれば名前・モデル・稼働状態を更新する
+func (idx *chairIndex) putChair(chair *Chair) {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+	c, ok := idx.chairs[chair.ID]
+	if !ok {
+		c = &indexedChair{ID: chair.ID, rides: map[string]bool{}}
+		idx.chairs[chair.ID] = c
+	}
+	c.Name = chair.Name
+	c.Model = chair.Model
+	c.active = chair.IsActive && chair.RetiredAt == nil
+	idx.reindex(c)
+}
+
+// setActive 椅子の稼働状態を変える
+func (idx *chairIndex) setActive(chairID string, active bool) {
+	idx.update(chairID, func(c *indexedChair) { c.active = active })
+}
+
+// move 椅子の最新の位置を変える
+func (idx *chairIndex) move(chairID string, coordinate Coordinate) {
+	idx.update(chairID, func(c *indexedChair) {
+		c.Coordinate = coordinate
+		c.hasLocation = true
+	})
+}
+
+// addRide 椅子にライドが割り当てられたことを反映する
+func (idx *chairIndex) addRide(chairID, rideID string) {
+	idx.update(chairID, func(c *indexedChair) {
+		if idx.finishedRides[rideID] {
+			delete(idx.finishedRides, rideID)
+			return
+		}
+		c.rides[rideID] = true
+	})
+}
+
+// finishRide 椅子のライドが終わったか、椅子から外されたことを反映する
+func (idx *chairIndex) finishRide(chairID, rideID string) {
+	idx.update(chairID, func(c *indexedChair) {
+		if !c.rides[rideID] {
+			idx.finishedRides[rideID] = true
+			return
+		}
+		delete(c.rides, rideID)
+	})
+}
+
+// search 指定した位置からマンハッタン距離がdistance以内にある空いている椅子を返す
+func (idx *chairIndex) search(center Coordinate, distance int) []indexedChair {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+
+	result := []indexedChair{}
+	collect := func(chairs map[string]*indexedChair) {
+		for _, c := range chairs {
+			if calculateDistance(center.Latitude, center.Longitude, c.Coordinate.Latitude, c.Coordinate.Longitude) <= distance {
+				result = append(result, *c)
+			}
+		}
+	}
+
+	from := chairIndexCellOf(center.Latitude-distance, center.Longitude-distance)
+	to := chairIndexCellOf(center.Latitude+distance, center.Longitude+distance)
+	// 範囲が広くて見るマスが空いている椅子のあるマスより多ければ、椅子のあるマスだけを見る
+	if (to.latitude-from.latitude+1)*(to.longitude-from.longitude+1) > len(idx.cells) {
+		for _, chairs := range idx.cells {
+			collect(chairs)
+		}
+		return result
+	}
+	for lat := from.latitude; lat <= to.latitude; lat++ {
+		for lon := from.longitude; lon <= to.longitude; lon++ {
+			collect(idx.cells[chairIndexCell{latitude: lat, longitude: lon}])
+		}
+	}
+	return result
+}
+
+// loadChairIndex DBの椅子・最新の位置・終わっていないライドから索引を作り直す
+func loadChairIndex(ctx context.Context) error {
+	chairs := []Chair{}
+	if err := db.SelectContext(ctx, &chairs, `SELECT * FROM chairs`); err != nil {
+		return err
+	}
+	locations := []struct {
+		ChairID   string `db:"chair_id"`
+		Latitude  int    `db:"latitude"`
+		Longitude int    `db:"longitude"`
+	}{}
+	// 最新の位置はchair_distancesに記録されている
+	if err := db.SelectContext(ctx, &locations, `SELECT chair_id, latitude, longitude FROM chair_distances`); err != nil {
+		return err
+	}
+	rides := []struct {
+		ID      string `db:"id"`
+		ChairID string `db:"chair_id"`
+	}{}
+	if err := db.SelectContext(ctx, &rides, `SELECT id, chair_id
+FROM rides
+WHERE chair_id IS NOT NULL
+  AND NOT EXISTS (SELECT 1 FROM ride_statuses WHERE ride_statuses.ride_id = rides.id AND ride_statuses.status IN ('COMPLETED', 'CANCELED'))`); err != nil {
+		return err
+	}
+
+	indexed := map[string]*indexedChair{}
+	list := make([]*indexedChair, 0, len(chairs))
+	for _, chair := range chairs {
+		c := &indexedChair{
+			ID:     chair.ID,
+			Name:   chair.Name,
+			Model:  chair.Model,
+			active: chair.IsActive && chair.RetiredAt == nil,
+			rides:  map[string]bool{},
+		}
+		indexed[chair.ID] = c
+		list = append(list, c)
+	}
+	for _, location := range locations {
+		if c, ok := indexed[location.ChairID]; ok {
+			c.Coordinate = Coordinate{Latitude: location.Latitude, Longitude: location.Longitude}
+			c.hasLocation = true
+		}
+	}
+	for _, ride := range rides {
+		if c, ok := indexed[ride.ChairID]; ok {
+			c.rides[ride.ID] = true
+		}
+	}
+
+	availableChairs.reset(list)
+	return nil
+}
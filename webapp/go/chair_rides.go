@@ -0,0 +1,303 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// 椅子が運んだライドの履歴と売上を、椅子自身とオーナーが確認できるようにする
+// 椅子の売上は、オーナーの売上情報と同じクエリ(queryChairEarnings)を椅子で絞り込んで求める
+
+const (
+	// defaultChairRidesLimit ライドの履歴を1回で返す件数
+	defaultChairRidesLimit = 20
+	// maxChairRidesLimit ライドの履歴を1回で返す件数の上限
+	maxChairRidesLimit = 100
+)
+
+var errInvalidCursor = errors.New("invalid cursor")
+
+// parsePeriod クエリパラメータのsince, until (UNIXミリ秒) から期間を求める。指定が無ければ全期間になる
+func parsePeriod(r *http.Request) (since, until time.Time, err error) {
+	since = time.Unix(0, 0)
+	until = time.Date(9999, 12, 31, 23, 59, 59, 0, time.UTC)
+	if r.URL.Query().Get("since") != "" {
+		parsed, err := strconv.ParseInt(r.URL.Query().Get("since"), 10, 64)
+		if err != nil {
+			return since, until, err
+		}
+		since = time.UnixMilli(parsed)
+	}
+	if r.URL.Query().Get("until") != "" {
+		parsed, err := strconv.ParseInt(r.URL.Query().Get("until"), 10, 64)
+		if err != nil {
+			return since, until, err
+		}
+		until = time.UnixMilli(parsed)
+	}
+	return since, until, nil
+}
+
+// chairEarnings 椅子の期間中の売上の内訳
+type chairEarnings struct {
+	// RideSales 期間中に完了したライドの売上
+	RideSales int
+	// CancellationFees 期間中にキャンセルされたライドのキャンセル料
+	CancellationFees int
+	// Refunds 期間中に登録された返金額。決済マイクロサービスで返金が済んだものだけを含む
+	Refunds int
+	// Tips 期間中に完了したライドのチップ。運賃とは別に扱い、売上には含めない
+	Tips int
+	// CompletedRides 期間中に完了したライドの数
+	CompletedRides int
+}
+
+func (e chairEarnings) total() int {
+	return e.RideSales + e.CancellationFees - e.Refunds
+}
+
+type chairRide struct {
+	Ride
+	Status          string        `db:"status"`
+	CancellationFee sql.NullInt64 `db:"cancellation_fee"`
+}
+
+type chairRidesResponse struct {
+	Rides []chairRidesResponseItem `json:"rides"`
+	// NextCursor 続きのライドを取得する時にcursorに指定する値。続きが無ければ含まれない
+	NextCursor string `json:"next_cursor,omitempty"`
+}
+
+type chairRidesResponseItem struct {
+	ID                    string     `json:"id"`
+	User                  simpleUser `json:"user"`
+	PickupCoordinate      Coordinate `json:"pickup_coordinate"`
+	DestinationCoordinate Coordinate `json:"destination_coordinate"`
+	Status                string     `json:"status"`
+	// Sales 椅子の売上。完了したライドでは運賃、キャンセルされたライドではキャンセル料になる
+	Sales       int    `json:"sales"`
+	Evaluation  *int   `json:"evaluation,omitempty"`
+	RequestedAt int64  `json:"requested_at"`
+	CompletedAt *int64 `json:"completed_at,omitempty"`
+}
+
+// parseChairRidesPage クエリパラメータのlimit, cursorを読み取る
+func parseChairRidesPage(r *http.Request) (limit int, cursor string, err error) {
+	limit = defaultChairRidesLimit
+	if v := r.URL.Query().Get("limit"); v != "" {
+		limit, err = strconv.Atoi(v)
+		if err != nil {
+			return 0, "", err
+		}
+		if limit < 1 || limit > maxChairRidesLimit {
+			return 0, "", errors.New("limit is out of range")
+		}
+	}
+	return limit, r.URL.Query().Get("cursor"), nil
+}
+
+// getChairRides 椅子に割り当てられたライドを新しい順に取得する
+// cursorには前のページの最後のライドIDを指定し、そのライドより古いライドを返す
+func getChairRides(ctx context.Context, tx *sqlx.Tx, chairID string, limit int, cursor string) (*chairRidesResponse, error) {
+	query := `SELECT rides.*,
+       (SELECT status FROM ride_statuses WHERE ride_statuses.ride_id = rides.id ORDER BY created_at DESC LIMIT 1) AS status,
+       ride_cancellations.fee AS cancellation_fee
+FROM rides
+       LEFT JOIN ride_cancellations ON ride_cancellations.ride_id = rides.id
+WHERE rides.chair_id = ?`
+	args := []any{chairID}
+	if cursor != "" {
+		last := &Ride{}
+		if err := tx.GetContext(ctx, last, `SELECT * FROM rides WHERE id = ? AND chair_id = ?`, cursor, chairID); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return nil, errInvalidCursor
+			}
+			return nil, err
+		}
+		query += ` AND (rides.created_at < ? OR (rides.created_at = ? AND rides.id < ?))`
+		args = append(args, last.CreatedAt, last.CreatedAt, last.ID)
+	}
+	// 続きがあるかを知るために1件多く取得する
+	query += ` ORDER BY rides.created_at DESC, rides.id DESC LIMIT ?`
+	args = append(args, limit+1)
+
+	rides := []chairRide{}
+	if err := tx.SelectContext(ctx, &rides, query, args...); err != nil {
+		return nil, err
+	}
+
+	res := &chairRidesResponse{Rides: []chairRidesResponseItem{}}
+	if len(rides) > limit {
+		rides = rides[:limit]
+		res.NextCursor = rides[limit-1].ID
+	}
+	for _, ride := range rides {
+		user := &User{}
+		if err := tx.GetContext(ctx, user, `SELECT * FROM users WHERE id = ?`, ride.UserID); err != nil {
+			return nil, err
+		}
+
+		item := chairRidesResponseItem{
+			ID: ride.ID,
+			User: simpleUser{
+				ID:   user.ID,
+				Name: fmt.Sprintf("%s %s", user.Firstname, user.Lastname),
+			},
+			PickupCoordinate: Coordinate{
+				Latitude:  ride.PickupLatitude,
+				Longitude: ride.PickupLongitude,
+			},
+			DestinationCoordinate: Coordinate{
+				Latitude:  ride.DestinationLatitude,
+				Longitude: ride.DestinationLongitude,
+			},
+			Status:      ride.Status,
+			Evaluation:  ride.Evaluation,
+			RequestedAt: ride.CreatedAt.UnixMilli(),
+		}
+		switch {
+		case ride.Status == "COMPLETED":
+			item.Sales = calculateSale(ride.Ride)
+			completedAt := ride.UpdatedAt.UnixMilli()
+			item.CompletedAt = &completedAt
+		case ride.CancellationFee.Valid:
+			item.Sales = int(ride.CancellationFee.Int64)
+		}
+		res.Rides = append(res.Rides, item)
+	}
+	return res, nil
+}
+
+// chairGetRides 椅子が自身に割り当てられたライドの履歴を取得する
+func chairGetRides(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	chair := ctx.Value("chair").(*Chair)
+
+	limit, cursor, err := parseChairRidesPage(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	tx, err := db.Beginx()
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	defer tx.Rollback()
+
+	res, err := getChairRides(ctx, tx, chair.ID, limit, cursor)
+	if err != nil {
+		if errors.Is(err, errInvalidCursor) {
+			writeError(w, http.StatusBadRequest, err)
+			return
+		}
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	if err := tx.Commit(); err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, res)
+}
+
+type chairGetEarningsResponse struct {
+	TotalSales       int `json:"total_sales"`
+	RideSales        int `json:"ride_sales"`
+	CancellationFees int `json:"cancellation_fees"`
+	Refunds          int `json:"refunds"`
+	Tips             int `json:"tips"`
+	CompletedRides   int `json:"completed_rides"`
+}
+
+// chairGetEarnings 椅子が指定期間の売上を取得する
+func chairGetEarnings(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	chair := ctx.Value("chair").(*Chair)
+
+	since, until, err := parsePeriod(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	tx, err := db.Beginx()
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	defer tx.Rollback()
+
+	earnings, err := getChairEarnings(ctx, tx, chair.ID, since, until)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	if err := tx.Commit(); err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, &chairGetEarningsResponse{
+		TotalSales:       earnings.total(),
+		RideSales:        earnings.RideSales,
+		CancellationFees: earnings.CancellationFees,
+		Refunds:          earnings.Refunds,
+		Tips:             earnings.Tips,
+		CompletedRides:   earnings.CompletedRides,
+	})
+}
+
+// ownerGetChairRides オーナーが所有する椅子のライドの履歴を取得する
+func ownerGetChairRides(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	owner := ctx.Value("owner").(*Owner)
+	chairID := r.PathValue("chair_id")
+
+	limit, cursor, err := parseChairRidesPage(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	tx, err := db.Beginx()
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	defer tx.Rollback()
+
+	chair, err := getOwnerChair(ctx, tx, owner.ID, chairID)
+	if err != nil {
+		writeOwnerChairError(w, err)
+		return
+	}
+
+	res, err := getChairRides(ctx, tx, chair.ID, limit, cursor)
+	if err != nil {
+		if errors.Is(err, errInvalidCursor) {
+			writeError(w, http.StatusBadRequest, err)
+			return
+		}
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	if err := tx.Commit(); err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, res)
+}
@@ -0,0 +1,283 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// オーナーが椅子の移動の軌跡を確認できるようにする。評価の低いライドの原因を調べるために使う
+// 点の数が多い時は、max_pointsを指定すると軌跡の形を保ったまま点を間引く
+
+type trackPoint struct {
+	Coordinate Coordinate `json:"coordinate"`
+	RecordedAt int64      `json:"recorded_at"`
+}
+
+// parseMaxPoints クエリパラメータのmax_pointsを読み取る。指定が無ければ0を返し、間引かない
+func parseMaxPoints(r *http.Request) (int, error) {
+	v := r.URL.Query().Get("max_points")
+	if v == "" {
+		return 0, nil
+	}
+	maxPoints, err := strconv.Atoi(v)
+	if err != nil {
+		return 0, err
+	}
+	// 始点と終点は必ず残すので2点以上にする
+	if maxPoints < 2 {
+		return 0, errors.New("max_points must be at least 2")
+	}
+	return maxPoints, nil
+}
+
+// getChairTrack 椅子が期間中に記録した位置情報を記録順に取得する
+func getChairTrack(ctx context.Context, tx *sqlx.Tx, chairID string, since, until time.Time) ([]trackPoint, error) {
+	locations := []ChairLocation{}
+	if err := tx.SelectContext(
+		ctx,
+		&locations,
+		`SELECT * FROM chair_locations WHERE chair_id = ? AND created_at BETWEEN ? AND ? + INTERVAL 999 MICROSECOND ORDER BY created_at`,
+		chairID, since, until,
+	); err != nil {
+		return nil, err
+	}
+
+	points := make([]trackPoint, 0, len(locations))
+	for _, location := range locations {
+		points = append(points, trackPoint{
+			Coordinate: Coordinate{Latitude: location.Latitude, Longitude: location.Longitude},
+			RecordedAt: location.CreatedAt.UnixMilli(),
+		})
+	}
+	return points, nil
+}
+
+// simplifyTrack 軌跡をmaxPoints個以下の点に間引く。始点と終点は必ず残すので、maxPointsが2未満の時は間引かない
+// 始点と終点から始めて、選んだ点を結ぶ線分から最も離れている点を順に選んでいく (Douglas-Peucker法を点の数で打ち切ったもの)
+func simplifyTrack(points []trackPoint, maxPoints int) []trackPoint {
+	if maxPoints < 2 || len(points) <= maxPoints {
+		return points
+	}
+
+	selected := make([]bool, len(points))
+	selected[0] = true
+	selected[len(points)-1] = true
+	for count := 2; count < maxPoints; count++ {
+		farthest, farthestDistance := -1, -1.0
+		start := 0
+		for end := 1; end < len(points); end++ {
+			if !selected[end] {
+				continue
+			}
+			for i := start + 1; i < end; i++ {
+				if d := distanceToSegment(points[i].Coordinate, points[start].Coordinate, points[end].Coordinate); d > farthestDistance {
+					farthest, farthestDistance = i, d
+				}
+			}
+			start = end
+		}
+		if farthest < 0 {
+			break
+		}
+		selected[farthest] = true
+	}
+
+	simplified := make([]trackPoint, 0, maxPoints)
+	for i, point := range points {
+		if selected[i] {
+			simplified = append(simplified, point)
+		}
+	}
+	return simplified
+}
+
+// distanceToSegment 点pから線分abまでのユークリッド距離
+func distanceToSegment(p, a, b Coordinate) float64 {
+	px, py := float64(p.Latitude-a.Latitude), float64(p.Longitude-a.Longitude)
+	bx, by := float64(b.Latitude-a.Latitude), float64(b.Longitude-a.Longitude)
+	length := bx*bx + by*by
+	if length == 0 {
+		return math.Hypot(px, py)
+	}
+	t := max(0, min(1, (px*bx+py*by)/length))
+	return math.Hypot(px-t*bx, py-t*by)
+}
+
+// getOwnerChair オーナーが管理している椅子を取得する
+func getOwnerChair(ctx context.Context, tx *sqlx.Tx, ownerID, chairID string) (*Chair, error) {
+	chair := &Chair{}
+	if err := tx.GetContext(ctx, chair, `SELECT * FROM chairs WHERE id = ? AND owner_id = ?`, chairID, ownerID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errChairNotFound
+		}
+		return nil, err
+	}
+	return chair, nil
+}
+
+type ownerGetChairTrackResponse struct {
+	Points []trackPoint `json:"points"`
+}
+
+// ownerGetChairTrack オーナーが管理している椅子の、指定期間の移動の軌跡を取得する
+func ownerGetChairTrack(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	owner := ctx.Value("owner").(*Owner)
+	chairID := r.PathValue("chair_id")
+
+	since, until, err := parsePeriod(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	maxPoints, err := parseMaxPoints(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	tx, err := db.Beginx()
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	defer tx.Rollback()
+
+	chair, err := getOwnerChair(ctx, tx, owner.ID, chairID)
+	if err != nil {
+		writeOwnerChairError(w, err)
+		return
+	}
+
+	points, err := getChairTrack(ctx, tx, chair.ID, since, until)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	if err := tx.Commit(); err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, &ownerGetChairTrackResponse{
+		Points: simplifyTrack(points, maxPoints),
+	})
+}
+
+// getRideRouteWindow ライドで椅子が配車位置へ向かい始めた状態と、目的地に到着もしくはキャンセルされた状態を取得する
+// まだ向かい始めていなければenrouteが、まだ到着もキャンセルもしていなければendがnilになる
+func getRideRouteWindow(ctx context.Context, tx *sqlx.Tx, rideID string) (enroute, end *RideStatus, err error) {
+	// 他の椅子が辞退したライドでは何度かENROUTEになっているので、最後のENROUTEから見る
+	enroute = &RideStatus{}
+	if err := tx.GetContext(ctx, enroute, `SELECT * FROM ride_statuses WHERE ride_id = ? AND status = 'ENROUTE' ORDER BY created_at DESC LIMIT 1`, rideID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil, nil
+		}
+		return nil, nil, err
+	}
+	end = &RideStatus{}
+	if err := tx.GetContext(ctx, end, `SELECT * FROM ride_statuses WHERE ride_id = ? AND status IN ('ARRIVED', 'CANCELED') AND created_at >= ? ORDER BY created_at LIMIT 1`, rideID, enroute.CreatedAt); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return enroute, nil, nil
+		}
+		return nil, nil, err
+	}
+	return enroute, end, nil
+}
+
+type ownerGetRideRouteResponse struct {
+	RideID     string `json:"ride_id"`
+	Evaluation *int   `json:"evaluation,omitempty"`
+	// EnrouteAt 椅子が配車位置へ向かい始めた日時。まだ向かっていなければ含まれない
+	EnrouteAt *int64 `json:"enroute_at,omitempty"`
+	// ArrivedAt 椅子が目的地に到着した日時。まだ到着していなければ含まれない
+	ArrivedAt *int64       `json:"arrived_at,omitempty"`
+	Points    []trackPoint `json:"points"`
+}
+
+// ownerGetRideRoute オーナーが管理している椅子が、ライドで配車位置へ向かい始めてから目的地に到着するまでの軌跡を取得する
+// 到着する前にキャンセルされたライドはキャンセルまで、進行中のライドは現在までの軌跡を返す
+func ownerGetRideRoute(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	owner := ctx.Value("owner").(*Owner)
+	chairID := r.PathValue("chair_id")
+	rideID := r.PathValue("ride_id")
+
+	maxPoints, err := parseMaxPoints(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	tx, err := db.Beginx()
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	defer tx.Rollback()
+
+	chair, err := getOwnerChair(ctx, tx, owner.ID, chairID)
+	if err != nil {
+		writeOwnerChairError(w, err)
+		return
+	}
+
+	ride := &Ride{}
+	if err := tx.GetContext(ctx, ride, `SELECT * FROM rides WHERE id = ? AND chair_id = ?`, rideID, chair.ID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			writeError(w, http.StatusNotFound, errors.New("ride not found"))
+			return
+		}
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	res := &ownerGetRideRouteResponse{
+		RideID:     ride.ID,
+		Evaluation: ride.Evaluation,
+		Points:     []trackPoint{},
+	}
+
+	enroute, end, err := getRideRouteWindow(ctx, tx, ride.ID)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	if enroute == nil {
+		writeJSON(w, http.StatusOK, res)
+		return
+	}
+	enrouteAt := enroute.CreatedAt.UnixMilli()
+	res.EnrouteAt = &enrouteAt
+
+	until := time.Date(9999, 12, 31, 23, 59, 59, 0, time.UTC)
+	if end != nil {
+		until = end.CreatedAt
+		if end.Status == "ARRIVED" {
+			arrivedAt := end.CreatedAt.UnixMilli()
+			res.ArrivedAt = &arrivedAt
+		}
+	}
+
+	points, err := getChairTrack(ctx, tx, chair.ID, enroute.CreatedAt, until)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	if err := tx.Commit(); err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	res.Points = simplifyTrack(points, maxPoints)
+	writeJSON(w, http.StatusOK, res)
+}
@@ -0,0 +1,122 @@
+package main
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSimplifyTrack(t *testing.T) {
+	// track 座標の並びから、記録日時を1ずつずらした軌跡を作る
+	track := func(coordinates ...Coordinate) []trackPoint {
+		points := make([]trackPoint, 0, len(coordinates))
+		for i, c := range coordinates {
+			points = append(points, trackPoint{Coordinate: c, RecordedAt: int64(i)})
+		}
+		return points
+	}
+	c := func(latitude, longitude int) Coordinate {
+		return Coordinate{Latitude: latitude, Longitude: longitude}
+	}
+
+	tests := []struct {
+		name      string
+		points    []trackPoint
+		maxPoints int
+		expected  []trackPoint
+	}{
+		{
+			name:      "点が無ければそのまま返す",
+			points:    track(),
+			maxPoints: 2,
+			expected:  track(),
+		},
+		{
+			name:      "1点ならそのまま返す",
+			points:    track(c(0, 0)),
+			maxPoints: 2,
+			expected:  track(c(0, 0)),
+		},
+		{
+			name:      "2点ならそのまま返す",
+			points:    track(c(0, 0), c(10, 10)),
+			maxPoints: 2,
+			expected:  track(c(0, 0), c(10, 10)),
+		},
+		{
+			name:      "maxPointsが0なら間引かない",
+			points:    track(c(0, 0), c(1, 0), c(2, 0), c(3, 0)),
+			maxPoints: 0,
+			expected:  track(c(0, 0), c(1, 0), c(2, 0), c(3, 0)),
+		},
+		{
+			name:      "maxPointsが2未満なら始点と終点を残せないので間引かない",
+			points:    track(c(0, 0), c(1, 0), c(2, 0), c(3, 0)),
+			maxPoints: 1,
+			expected:  track(c(0, 0), c(1, 0), c(2, 0), c(3, 0)),
+		},
+		{
+			name:      "点の数がmaxPoints以下なら間引かない",
+			points:    track(c(0, 0), c(1, 0), c(2, 0)),
+			maxPoints: 3,
+			expected:  track(c(0, 0), c(1, 0), c(2, 0)),
+		},
+		{
+			name:      "maxPointsが2なら始点と終点だけを残す",
+			points:    track(c(0, 0), c(1, 5), c(2, 0), c(3, 0)),
+			maxPoints: 2,
+			expected:  []trackPoint{{Coordinate: c(0, 0), RecordedAt: 0}, {Coordinate: c(3, 0), RecordedAt: 3}},
+		},
+		{
+			name:      "曲がり角を残す",
+			points:    track(c(0, 0), c(1, 0), c(2, 0), c(2, 1), c(2, 2)),
+			maxPoints: 3,
+			expected:  []trackPoint{{Coordinate: c(0, 0), RecordedAt: 0}, {Coordinate: c(2, 0), RecordedAt: 2}, {Coordinate: c(2, 2), RecordedAt: 4}},
+		},
+		{
+			name:      "同じ位置が続く時は最初の点を残す",
+			points:    track(c(0, 0), c(0, 0), c(5, 5), c(5, 5), c(10, 0)),
+			maxPoints: 3,
+			expected:  []trackPoint{{Coordinate: c(0, 0), RecordedAt: 0}, {Coordinate: c(5, 5), RecordedAt: 2}, {Coordinate: c(10, 0), RecordedAt: 4}},
+		},
+		{
+			name:      "全て同じ位置でもmaxPoints個の点を返す",
+			points:    track(c(1, 1), c(1, 1), c(1, 1), c(1, 1)),
+			maxPoints: 3,
+			expected:  []trackPoint{{Coordinate: c(1, 1), RecordedAt: 0}, {Coordinate: c(1, 1), RecordedAt: 1}, {Coordinate: c(1, 1), RecordedAt: 3}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, simplifyTrack(tt.points, tt.maxPoints))
+		})
+	}
+}
+
+func TestParseMaxPoints(t *testing.T) {
+	tests := []struct {
+		name     string
+		query    string
+		expected int
+		wantErr  bool
+	}{
+		{name: "指定しなければ間引かない", query: "", expected: 0},
+		{name: "指定した数で間引く", query: "?max_points=10", expected: 10},
+		{name: "2点は指定できる", query: "?max_points=2", expected: 2},
+		{name: "2点未満は指定できない", query: "?max_points=1", wantErr: true},
+		{name: "負の数は指定できない", query: "?max_points=-1", wantErr: true},
+		{name: "数でなければ指定できない", query: "?max_points=abc", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := parseMaxPoints(httptest.NewRequest("GET", "/api/owner/chairs/chair/track"+tt.query, nil))
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
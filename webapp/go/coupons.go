@@ -0,0 +1,155 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// クーポンはキャンペーンごとに付与する。登録時・招待時に付与するクーポンもキャンペーンとして管理する
+// ライドには、キャンペーンの優先度が高いクーポンから、同じ優先度なら付与された順に使う
+
+const (
+	// couponCampaignKindSignup ユーザー登録時に付与する
+	couponCampaignKindSignup = "SIGNUP"
+	// couponCampaignKindInvitee 招待コードを使って登録したユーザーに付与する
+	couponCampaignKindInvitee = "INVITEE"
+	// couponCampaignKindInviter 招待コードを使われたユーザーに付与する
+	couponCampaignKindInviter = "INVITER"
+	// couponCampaignKindCode ユーザーがコードを入力して受け取る
+	couponCampaignKindCode = "CODE"
+)
+
+// errCouponLimitExceeded キャンペーンで付与できるクーポンの上限に達している
+var errCouponLimitExceeded = errors.New("coupon limit exceeded")
+
+// getActiveCouponCampaign 種類ごとに、期間中で最も優先度の高いキャンペーンを取得する。無ければnilを返す
+func getActiveCouponCampaign(ctx context.Context, tx *sqlx.Tx, kind string) (*CouponCampaign, error) {
+	campaign := &CouponCampaign{}
+	if err := tx.GetContext(ctx, campaign, `SELECT *
+FROM coupon_campaigns
+WHERE kind = ?
+  AND (starts_at IS NULL OR starts_at <= CURRENT_TIMESTAMP(6))
+  AND (ends_at IS NULL OR ends_at > CURRENT_TIMESTAMP(6))
+ORDER BY priority DESC, created_at
+LIMIT 1`, kind); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return campaign, nil
+}
+
+// issueCoupon キャンペーンのクーポンをユーザーに付与する
+// uniqueCodeがtrueの場合は、同じユーザーに何枚でも付与できるようにコードの末尾に付与時刻を付ける
+func issueCoupon(ctx context.Context, tx *sqlx.Tx, campaign *CouponCampaign, userID, code string, uniqueCode bool) error {
+	if campaign.TotalLimit != nil {
+		// 総数の上限を超えて付与しないように、同じキャンペーンの付与を直列にする
+		// 総数の上限が無ければキャンペーンはロックしないので、登録や招待が1つの行のロックを待つことはない
+		var id string
+		if err := tx.GetContext(ctx, &id, "SELECT id FROM coupon_campaigns WHERE id = ? FOR UPDATE", campaign.ID); err != nil {
+			return err
+		}
+	}
+	if campaign.PerUserLimit != nil {
+		// 1ユーザーあたりの上限は、ユーザーをロックしてそのユーザーへの付与だけを直列にする
+		var id string
+		if err := tx.GetContext(ctx, &id, "SELECT id FROM users WHERE id = ? FOR UPDATE", userID); err != nil {
+			return err
+		}
+		var count int
+		if err := tx.GetContext(ctx, &count, "SELECT COUNT(*) FROM coupons WHERE campaign_id = ? AND user_id = ?", campaign.ID, userID); err != nil {
+			return err
+		}
+		if count >= *campaign.PerUserLimit {
+			return errCouponLimitExceeded
+		}
+	}
+	if campaign.TotalLimit != nil {
+		var count int
+		if err := tx.GetContext(ctx, &count, "SELECT COUNT(*) FROM coupons WHERE campaign_id = ?", campaign.ID); err != nil {
+			return err
+		}
+		if count >= *campaign.TotalLimit {
+			return errCouponLimitExceeded
+		}
+	}
+
+	// 割引率のキャンペーンの割引額は、ライドの運賃が決まった時に求める
+	discount := 0
+	if campaign.DiscountAmount != nil {
+		discount = *campaign.DiscountAmount
+	}
+	if uniqueCode {
+		_, err := tx.ExecContext(
+			ctx,
+			"INSERT INTO coupons (user_id, code, campaign_id, discount) VALUES (?, CONCAT(?, '_', FLOOR(UNIX_TIMESTAMP(NOW(3))*1000)), ?, ?)",
+			userID, code, campaign.ID, discount,
+		)
+		return err
+	}
+	_, err := tx.ExecContext(
+		ctx,
+		"INSERT INTO coupons (user_id, code, campaign_id, discount) VALUES (?, ?, ?, ?)",
+		userID, code, campaign.ID, discount,
+	)
+	return err
+}
+
+type usableCoupon struct {
+	Coupon
+	DiscountPercentage *int `db:"discount_percentage"`
+}
+
+// discountFor 距離に応じた運賃に対する割引額
+func (c *usableCoupon) discountFor(meteredFare int) int {
+	if c.DiscountPercentage != nil {
+		return meteredFare * *c.DiscountPercentage / 100
+	}
+	return c.Discount
+}
+
+// findUsableCoupon 次のライドに使うクーポンを取得する。無ければnilを返す
+// キャンペーンに紐づかないクーポンは、期限が無く優先度が既定値のキャンペーンのものとして扱う
+// forUpdateがtrueの場合は、ライドに使うためにクーポンをロックする
+func findUsableCoupon(ctx context.Context, tx *sqlx.Tx, userID string, forUpdate bool) (*usableCoupon, error) {
+	query := `SELECT coupons.*, coupon_campaigns.discount_percentage
+FROM coupons
+       LEFT JOIN coupon_campaigns ON coupon_campaigns.id = coupons.campaign_id
+WHERE coupons.user_id = ?
+  AND coupons.used_by IS NULL
+  AND (coupon_campaigns.ends_at IS NULL OR coupon_campaigns.ends_at > CURRENT_TIMESTAMP(6))
+ORDER BY COALESCE(coupon_campaigns.priority, 0) DESC, coupons.created_at
+LIMIT 1`
+	if forUpdate {
+		// キャンペーンはロックしないので、他のユーザーのライドの作成を待たせない
+		query += " FOR UPDATE OF coupons"
+	}
+
+	coupon := &usableCoupon{}
+	if err := tx.GetContext(ctx, coupon, query, userID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return coupon, nil
+}
+
+// findRideCoupon ライドに使っているクーポンを取得する。使っていなければnilを返す
+func findRideCoupon(ctx context.Context, tx *sqlx.Tx, rideID string) (*usableCoupon, error) {
+	coupon := &usableCoupon{}
+	if err := tx.GetContext(ctx, coupon, `SELECT coupons.*, coupon_campaigns.discount_percentage
+FROM coupons
+       LEFT JOIN coupon_campaigns ON coupon_campaigns.id = coupons.campaign_id
+WHERE coupons.used_by = ?`, rideID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return coupon, nil
+}
@@ -1,53 +1,197 @@
 package main
 
 import (
-	"database/sql"
 	"errors"
 	"net/http"
+	"time"
+
+	"github.com/oklog/ulid/v2"
 )
 
 // このAPIをインスタンス内から一定間隔で叩かせることで、椅子とライドをマッチングさせる
+// マッチング戦略は ISUCON_MATCHING_STRATEGY で切り替える
 func internalGetMatching(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
-	// MEMO: 一旦最も待たせているリクエストに適当な空いている椅子マッチさせる実装とする。おそらくもっといい方法があるはず…
-	ride := &Ride{}
-	if err := db.GetContext(ctx, ride, `SELECT * FROM rides WHERE chair_id IS NULL ORDER BY created_at LIMIT 1`); err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			w.WriteHeader(http.StatusNoContent)
-			return
-		}
+	if _, err := runMatching(ctx); err != nil {
 		writeError(w, http.StatusInternalServerError, err)
 		return
 	}
 
-	matched := &Chair{}
-	empty := false
-	for i := 0; i < 10; i++ {
-		if err := db.GetContext(ctx, matched, "SELECT * FROM chairs INNER JOIN (SELECT id FROM chairs WHERE is_active = TRUE ORDER BY RAND() LIMIT 1) AS tmp ON chairs.id = tmp.id LIMIT 1"); err != nil {
-			if errors.Is(err, sql.ErrNoRows) {
-				w.WriteHeader(http.StatusNoContent)
-				return
-			}
-			writeError(w, http.StatusInternalServerError, err)
-		}
+	w.WriteHeader(http.StatusNoContent)
+}
 
-		if err := db.GetContext(ctx, &empty, "SELECT COUNT(*) = 0 FROM (SELECT COUNT(chair_sent_at) = 6 AS completed FROM ride_statuses WHERE ride_id IN (SELECT id FROM rides WHERE chair_id = ?) GROUP BY ride_id) is_completed WHERE completed = FALSE", matched.ID); err != nil {
-			writeError(w, http.StatusInternalServerError, err)
-			return
-		}
-		if empty {
-			break
-		}
+type internalPostCouponCampaignsRequest struct {
+	Kind               string `json:"kind"`
+	CodePrefix         string `json:"code_prefix"`
+	DiscountAmount     *int   `json:"discount_amount"`
+	DiscountPercentage *int   `json:"discount_percentage"`
+	StartsAt           *int64 `json:"starts_at"`
+	EndsAt             *int64 `json:"ends_at"`
+	PerUserLimit       *int   `json:"per_user_limit"`
+	TotalLimit         *int   `json:"total_limit"`
+	Priority           int    `json:"priority"`
+}
+
+type internalPostCouponCampaignsResponse struct {
+	ID string `json:"id"`
+}
+
+// internalPostCouponCampaigns クーポンキャンペーンを登録する
+// キャンペーンは全てのユーザーに影響するので、オーナーではなくインスタンス内からのみ登録できるようにしている
+func internalPostCouponCampaigns(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	req := &internalPostCouponCampaignsRequest{}
+	if err := bindJSON(r, req); err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	if req.Kind == "" {
+		req.Kind = couponCampaignKindCode
+	}
+	switch req.Kind {
+	case couponCampaignKindSignup, couponCampaignKindInvitee, couponCampaignKindInviter, couponCampaignKindCode:
+	default:
+		writeError(w, http.StatusBadRequest, errors.New("invalid kind"))
+		return
 	}
-	if !empty {
-		w.WriteHeader(http.StatusNoContent)
+	if req.CodePrefix == "" {
+		writeError(w, http.StatusBadRequest, errors.New("some of required fields(code_prefix) are empty"))
 		return
 	}
+	if (req.DiscountAmount == nil) == (req.DiscountPercentage == nil) {
+		writeError(w, http.StatusBadRequest, errors.New("either discount_amount or discount_percentage must be specified"))
+		return
+	}
+	if req.DiscountAmount != nil && *req.DiscountAmount <= 0 {
+		writeError(w, http.StatusBadRequest, errors.New("discount_amount must be positive"))
+		return
+	}
+	if req.DiscountPercentage != nil && (*req.DiscountPercentage <= 0 || *req.DiscountPercentage > 100) {
+		writeError(w, http.StatusBadRequest, errors.New("discount_percentage must be between 1 and 100"))
+		return
+	}
+	if req.StartsAt != nil && req.EndsAt != nil && *req.StartsAt >= *req.EndsAt {
+		writeError(w, http.StatusBadRequest, errors.New("ends_at must be after starts_at"))
+		return
+	}
+	if (req.PerUserLimit != nil && *req.PerUserLimit <= 0) || (req.TotalLimit != nil && *req.TotalLimit <= 0) {
+		writeError(w, http.StatusBadRequest, errors.New("limits must be positive"))
+		return
+	}
+	// コード入力用のキャンペーンは、同じユーザーが何度もコードを入力して割引を受け続けないように、指定が無ければ1人1枚にする
+	if req.Kind == couponCampaignKindCode && req.PerUserLimit == nil {
+		perUserLimit := 1
+		req.PerUserLimit = &perUserLimit
+	}
+
+	var startsAt, endsAt *time.Time
+	if req.StartsAt != nil {
+		t := time.UnixMilli(*req.StartsAt)
+		startsAt = &t
+	}
+	if req.EndsAt != nil {
+		t := time.UnixMilli(*req.EndsAt)
+		endsAt = &t
+	}
 
-	if _, err := db.ExecContext(ctx, "UPDATE rides SET chair_id = ? WHERE id = ?", matched.ID, ride.ID); err != nil {
+	tx, err := db.Beginx()
+	if err != nil {
 		writeError(w, http.StatusInternalServerError, err)
 		return
 	}
+	defer tx.Rollback()
 
-	w.WriteHeader(http.StatusNoContent)
+	var count int
+	if err := tx.GetContext(ctx, &count, "SELECT COUNT(*) FROM coupon_campaigns WHERE code_prefix = ? FOR UPDATE", req.CodePrefix); err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	if count > 0 {
+		writeError(w, http.StatusConflict, errors.New("campaign with the same code_prefix already exists"))
+		return
+	}
+
+	campaignID := ulid.Make().String()
+	if _, err := tx.ExecContext(
+		ctx,
+		`INSERT INTO coupon_campaigns (id, kind, code_prefix, discount_amount, discount_percentage, starts_at, ends_at, per_user_limit, total_limit, priority)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		campaignID, req.Kind, req.CodePrefix, req.DiscountAmount, req.DiscountPercentage, startsAt, endsAt, req.PerUserLimit, req.TotalLimit, req.Priority,
+	); err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	if err := tx.Commit(); err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, &internalPostCouponCampaignsResponse{ID: campaignID})
+}
+
+type couponCampaignWithIssuedCount struct {
+	CouponCampaign
+	IssuedCount int `db:"issued_count"`
+}
+
+type internalGetCouponCampaignsResponse struct {
+	Campaigns []internalGetCouponCampaignsResponseCampaign `json:"campaigns"`
+}
+
+type internalGetCouponCampaignsResponseCampaign struct {
+	ID                 string `json:"id"`
+	Kind               string `json:"kind"`
+	CodePrefix         string `json:"code_prefix"`
+	DiscountAmount     *int   `json:"discount_amount,omitempty"`
+	DiscountPercentage *int   `json:"discount_percentage,omitempty"`
+	StartsAt           *int64 `json:"starts_at,omitempty"`
+	EndsAt             *int64 `json:"ends_at,omitempty"`
+	PerUserLimit       *int   `json:"per_user_limit,omitempty"`
+	TotalLimit         *int   `json:"total_limit,omitempty"`
+	Priority           int    `json:"priority"`
+	IssuedCount        int    `json:"issued_count"`
+	CreatedAt          int64  `json:"created_at"`
+}
+
+// internalGetCouponCampaigns クーポンキャンペーンの一覧を、ライドに適用する優先度の高い順に返す
+func internalGetCouponCampaigns(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	campaigns := []couponCampaignWithIssuedCount{}
+	if err := db.SelectContext(ctx, &campaigns, `SELECT coupon_campaigns.*, IFNULL(issued.count, 0) AS issued_count
+FROM coupon_campaigns
+       LEFT JOIN (SELECT campaign_id, COUNT(*) AS count FROM coupons GROUP BY campaign_id) issued
+                 ON issued.campaign_id = coupon_campaigns.id
+ORDER BY coupon_campaigns.priority DESC, coupon_campaigns.created_at
+`); err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	res := internalGetCouponCampaignsResponse{Campaigns: []internalGetCouponCampaignsResponseCampaign{}}
+	for _, campaign := range campaigns {
+		c := internalGetCouponCampaignsResponseCampaign{
+			ID:                 campaign.ID,
+			Kind:               campaign.Kind,
+			CodePrefix:         campaign.CodePrefix,
+			DiscountAmount:     campaign.DiscountAmount,
+			DiscountPercentage: campaign.DiscountPercentage,
+			PerUserLimit:       campaign.PerUserLimit,
+			TotalLimit:         campaign.TotalLimit,
+			Priority:           campaign.Priority,
+			IssuedCount:        campaign.IssuedCount,
+			CreatedAt:          campaign.CreatedAt.UnixMilli(),
+		}
+		if campaign.StartsAt != nil {
+			t := campaign.StartsAt.UnixMilli()
+			c.StartsAt = &t
+		}
+		if campaign.EndsAt != nil {
+			t := campaign.EndsAt.UnixMilli()
+			c.EndsAt = &t
+		}
+		res.Campaigns = append(res.Campaigns, c)
+	}
+	writeJSON(w, http.StatusOK, res)
 }
@@ -0,0 +1,162 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// chair_locationsが増え続けないように、古い位置情報を定期的に間引いて消す
+// ライドで配車位置へ向かい始めてから到着もしくはキャンセルされるまでの位置情報は軌跡の確認に使うので全て残し、それ以外は一定時間ごとに最後の位置だけを残す
+// 間引いたことで位置情報から求まらなくなった移動距離はchair_location_compactionsに記録し、総移動距離を求め直す時に足す
+
+var (
+	// locationRetention この期間より古い位置情報を間引く。0以下なら間引かない
+	locationRetention time.Duration
+	// locationCompactionInterval 位置情報を間引く間隔
+	locationCompactionInterval time.Duration
+)
+
+// locationDownsampleInterval ライドに関係しない位置情報は、この時間ごとに最後の位置だけを残す
+const locationDownsampleInterval = time.Minute
+
+// locationDeleteBatchSize 一度に消す位置情報の数
+const locationDeleteBatchSize = 1000
+
+func runLocationCompactionLoop(ctx context.Context) {
+	if locationRetention <= 0 || locationCompactionInterval <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(locationCompactionInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		if err := compactChairLocations(ctx, time.Now().Add(-locationRetention)); err != nil && ctx.Err() == nil {
+			slog.Error("failed to compact chair locations", slog.Any("error", err))
+		}
+	}
+}
+
+// compactChairLocations cutoffより前に記録された位置情報を椅子ごとに間引く
+func compactChairLocations(ctx context.Context, cutoff time.Time) error {
+	chairIDs := []string{}
+	if err := db.SelectContext(ctx, &chairIDs, `SELECT DISTINCT chair_id FROM chair_locations WHERE created_at < ?`, cutoff); err != nil {
+		return err
+	}
+	for _, chairID := range chairIDs {
+		if err := compactChairLocationsOf(ctx, chairID, cutoff); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type rideRouteWindow struct {
+	EnrouteAt time.Time    `db:"enroute_at"`
+	EndAt     sql.NullTime `db:"end_at"`
+}
+
+// contains 位置情報の登録日時がライドの軌跡に含まれるか。まだ到着もキャンセルもしていなければ、向かい始めてから後は全て含まれる
+func (w rideRouteWindow) contains(t time.Time) bool {
+	return !t.Before(w.EnrouteAt) && (!w.EndAt.Valid || !t.After(w.EndAt.Time))
+}
+
+// compactChairLocationsOf 椅子がcutoffより前に記録した位置情報を間引く
+// 残した位置を順に結んだ距離が元の距離より短くなった分を、chair_location_compactionsに足す
+func compactChairLocationsOf(ctx context.Context, chairID string, cutoff time.Time) error {
+	tx, err := db.Beginx()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	locations := []ChairLocation{}
+	if err := tx.SelectContext(ctx, &locations, `SELECT * FROM chair_locations WHERE chair_id = ? AND created_at < ? ORDER BY created_at`, chairID, cutoff); err != nil {
+		return err
+	}
+	if len(locations) == 0 {
+		return nil
+	}
+
+	// getRideRouteWindowと同じく、最後のENROUTEからその後の最初のARRIVEDもしくはCANCELEDまでをライドの軌跡とする
+	windows := []rideRouteWindow{}
+	if err := tx.SelectContext(ctx, &windows, `SELECT enroute_at,
+       (SELECT MIN(created_at)
+        FROM ride_statuses
+        WHERE ride_statuses.ride_id = tmp.ride_id
+          AND ride_statuses.status IN ('ARRIVED', 'CANCELED')
+          AND ride_statuses.created_at >= tmp.enroute_at) AS end_at
+FROM (SELECT rides.id AS ride_id,
+             (SELECT MAX(created_at) FROM ride_statuses WHERE ride_statuses.ride_id = rides.id AND ride_statuses.status = 'ENROUTE') AS enroute_at
+      FROM rides
+      WHERE rides.chair_id = ?
+        AND rides.created_at < ?) tmp
+WHERE enroute_at IS NOT NULL`, chairID, cutoff); err != nil {
+		return err
+	}
+
+	removedIDs := []string{}
+	originalDistance, compactedDistance := 0, 0
+	var lastKept *ChairLocation
+	for i := range locations {
+		location := &locations[i]
+		if i > 0 {
+			prev := &locations[i-1]
+			originalDistance += calculateDistance(prev.Latitude, prev.Longitude, location.Latitude, location.Longitude)
+		}
+		if !shouldKeepLocation(locations, i, windows) {
+			removedIDs = append(removedIDs, location.ID)
+			continue
+		}
+		if lastKept != nil {
+			compactedDistance += calculateDistance(lastKept.Latitude, lastKept.Longitude, location.Latitude, location.Longitude)
+		}
+		lastKept = location
+	}
+	if len(removedIDs) == 0 {
+		return nil
+	}
+
+	for start := 0; start < len(removedIDs); start += locationDeleteBatchSize {
+		query, args, err := sqlx.In(`DELETE FROM chair_locations WHERE id IN (?)`, removedIDs[start:min(start+locationDeleteBatchSize, len(removedIDs))])
+		if err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(ctx, tx.Rebind(query), args...); err != nil {
+			return err
+		}
+	}
+
+	if _, err := tx.ExecContext(ctx, `INSERT INTO chair_location_compactions (chair_id, removed_distance) VALUES (?, ?) AS new
+ON DUPLICATE KEY UPDATE removed_distance = removed_distance + new.removed_distance`, chairID, originalDistance-compactedDistance); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// shouldKeepLocation 間引く時にi番目の位置情報を残すか
+// 一定時間ごとの最後の位置は残すので、間引く範囲で最後の位置も必ず残り、それより後の位置との距離は変わらない
+func shouldKeepLocation(locations []ChairLocation, i int, windows []rideRouteWindow) bool {
+	if i == len(locations)-1 {
+		return true
+	}
+	if !locations[i].CreatedAt.Truncate(locationDownsampleInterval).Equal(locations[i+1].CreatedAt.Truncate(locationDownsampleInterval)) {
+		return true
+	}
+	for _, w := range windows {
+		if w.contains(locations[i].CreatedAt) {
+			return true
+		}
+	}
+	return false
+}
@@ -1,15 +1,21 @@
 package main
 
 import (
+	"context"
 	crand "crypto/rand"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log/slog"
 	"net"
 	"net/http"
 	"os"
 	"os/exec"
+	"os/signal"
 	"strconv"
+	"sync"
+	"syscall"
+	"time"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/chi/v5/middleware"
@@ -20,12 +26,65 @@ import (
 var db *sqlx.DB
 
 func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	// 既存のデータから椅子の総移動距離を求め直す、一度きりの移行用のコマンド
+	// 初期データの総移動距離は4-migration.sqlで求めるので、POST /api/initializeでは使わない
+	if len(os.Args) > 1 && os.Args[1] == "backfill-chair-distances" {
+		connectDB()
+		if err := backfillChairDistances(ctx); err != nil {
+			slog.Error("failed to backfill chair distances", slog.Any("error", err))
+			os.Exit(1)
+		}
+		return
+	}
+
 	mux := setup()
-	slog.Info("Listening on :8080")
-	http.ListenAndServe(":8080", mux)
+	srv := &http.Server{Addr: ":8080", Handler: mux}
+	// Shutdownは処理中のリクエストの終了を待つので、終わらない通知のストリームは先に閉じる
+	srv.RegisterOnShutdown(closeNotificationStreams)
+
+	var wg sync.WaitGroup
+	wg.Add(4)
+	go func() {
+		defer wg.Done()
+		runMatchingLoop(ctx)
+	}()
+	go func() {
+		defer wg.Done()
+		runPaymentLoop(ctx)
+	}()
+	go func() {
+		defer wg.Done()
+		runLocationCompactionLoop(ctx)
+	}()
+	go func() {
+		defer wg.Done()
+		runNotificationPoller(ctx)
+	}()
+
+	go func() {
+		slog.Info("Listening on :8080")
+		if err := srv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			slog.Error("failed to serve", slog.Any("error", err))
+			stop()
+		}
+	}()
+
+	<-ctx.Done()
+	slog.Info("Shutting down")
+
+	// 処理中のリクエストが終わり、マッチング・決済・位置情報の間引き・通知のポーラーのループが止まるのを待ってから終了する
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if err := srv.Shutdown(shutdownCtx); err != nil {
+		slog.Error("failed to shutdown", slog.Any("error", err))
+	}
+	wg.Wait()
 }
 
-func setup() http.Handler {
+func connectDB() {
 	host := os.Getenv("ISUCON_DB_HOST")
 	if host == "" {
 		host = "127.0.0.1"
@@ -64,6 +123,93 @@ func setup() http.Handler {
 		panic(err)
 	}
 	db = _db
+}
+
+func setup() http.Handler {
+	connectDB()
+
+	if err := loadChairIndex(context.Background()); err != nil {
+		panic(err)
+	}
+
+	_matcher, err := newRideMatcher(os.Getenv("ISUCON_MATCHING_STRATEGY"))
+	if err != nil {
+		panic(err)
+	}
+	matcher = _matcher
+
+	// 既存のマッチング用スクリプトと同じく秒単位で指定する
+	interval := os.Getenv("ISUCON_MATCHING_INTERVAL")
+	if interval == "" {
+		interval = "0.5"
+	}
+	intervalSec, err := strconv.ParseFloat(interval, 64)
+	if err != nil {
+		panic(fmt.Sprintf("failed to convert matching interval from ISUCON_MATCHING_INTERVAL environment variable into float: %v", err))
+	}
+	matchingInterval = time.Duration(intervalSec * float64(time.Second))
+
+	// 予約したライドのマッチングを配車日時の何秒前から始めるか
+	leadTime := os.Getenv("ISUCON_SCHEDULED_RIDE_LEAD_TIME")
+	if leadTime == "" {
+		leadTime = "3"
+	}
+	leadTimeSec, err := strconv.ParseFloat(leadTime, 64)
+	if err != nil {
+		panic(fmt.Sprintf("failed to convert scheduled ride lead time from ISUCON_SCHEDULED_RIDE_LEAD_TIME environment variable into float: %v", err))
+	}
+	scheduledRideLeadTime = time.Duration(leadTimeSec * float64(time.Second))
+
+	// 椅子による評価の平均がこれ未満のユーザーのライドは、マッチングで後回しにする
+	threshold := os.Getenv("ISUCON_LOW_RATED_RIDER_THRESHOLD")
+	if threshold == "" {
+		threshold = "0"
+	}
+	lowRatedRiderThreshold, err = strconv.ParseFloat(threshold, 64)
+	if err != nil {
+		panic(fmt.Sprintf("failed to convert low rated rider threshold from ISUCON_LOW_RATED_RIDER_THRESHOLD environment variable into float: %v", err))
+	}
+
+	// この秒数より古い椅子の位置情報を間引く
+	retention := os.Getenv("ISUCON_LOCATION_RETENTION")
+	if retention == "" {
+		retention = "86400"
+	}
+	retentionSec, err := strconv.ParseFloat(retention, 64)
+	if err != nil {
+		panic(fmt.Sprintf("failed to convert location retention from ISUCON_LOCATION_RETENTION environment variable into float: %v", err))
+	}
+	locationRetention = time.Duration(retentionSec * float64(time.Second))
+
+	compactionInterval := os.Getenv("ISUCON_LOCATION_COMPACTION_INTERVAL")
+	if compactionInterval == "" {
+		compactionInterval = "600"
+	}
+	compactionIntervalSec, err := strconv.ParseFloat(compactionInterval, 64)
+	if err != nil {
+		panic(fmt.Sprintf("failed to convert location compaction interval from ISUCON_LOCATION_COMPACTION_INTERVAL environment variable into float: %v", err))
+	}
+	locationCompactionInterval = time.Duration(compactionIntervalSec * float64(time.Second))
+
+	// 評価のレスポンスを返した後に、決済をバックグラウンドで送るかどうか
+	asyncPayment := os.Getenv("ISUCON_ASYNC_PAYMENT")
+	if asyncPayment == "" {
+		asyncPayment = "false"
+	}
+	paymentAsync, err = strconv.ParseBool(asyncPayment)
+	if err != nil {
+		panic(fmt.Sprintf("failed to convert async payment from ISUCON_ASYNC_PAYMENT environment variable into bool: %v", err))
+	}
+
+	// 配車位置の周辺の需要と供給に応じて運賃を割り増すかどうか
+	surgePricingEnv := os.Getenv("ISUCON_SURGE_PRICING")
+	if surgePricingEnv == "" {
+		surgePricingEnv = "false"
+	}
+	surgePricing, err = strconv.ParseBool(surgePricingEnv)
+	if err != nil {
+		panic(fmt.Sprintf("failed to convert surge pricing from ISUCON_SURGE_PRICING environment variable into bool: %v", err))
+	}
 
 	mux := chi.NewRouter()
 	mux.Use(middleware.Logger)
@@ -76,11 +222,18 @@ func setup() http.Handler {
 
 		authedMux := mux.With(appAuthMiddleware)
 		authedMux.HandleFunc("POST /api/app/payment-methods", appPostPaymentMethods)
+		authedMux.HandleFunc("GET /api/app/payment-methods", appGetPaymentMethods)
+		authedMux.HandleFunc("POST /api/app/payment-methods/{payment_method_id}/default", appPostPaymentMethodDefault)
+		authedMux.HandleFunc("DELETE /api/app/payment-methods/{payment_method_id}", appDeletePaymentMethod)
+		authedMux.HandleFunc("POST /api/app/coupons", appPostCoupons)
 		authedMux.HandleFunc("GET /api/app/rides", appGetRides)
 		authedMux.HandleFunc("POST /api/app/rides", appPostRides)
 		authedMux.HandleFunc("POST /api/app/rides/estimated-fare", appPostRidesEstimatedFare)
+		authedMux.HandleFunc("PATCH /api/app/rides/{ride_id}", appPatchRide)
 		authedMux.HandleFunc("POST /api/app/rides/{ride_id}/evaluation", appPostRideEvaluatation)
-		authedMux.HandleFunc("GET /api/app/notification", appGetNotification)
+		authedMux.HandleFunc("POST /api/app/rides/{ride_id}/cancel", appPostRideCancel)
+		authedMux.HandleFunc("GET /api/app/rides/{ride_id}/payment", appGetRidePayment)
+		authedMux.HandleFunc("GET /api/app/notification", withEventStream(appGetNotificationSSE, appGetNotification))
 		authedMux.HandleFunc("GET /api/app/nearby-chairs", appGetNearbyChairs)
 	}
 
@@ -90,7 +243,19 @@ func setup() http.Handler {
 
 		authedMux := mux.With(ownerAuthMiddleware)
 		authedMux.HandleFunc("GET /api/owner/sales", ownerGetSales)
+		authedMux.HandleFunc("GET /api/owner/sales/timeseries", ownerGetSalesTimeseries)
+		authedMux.HandleFunc("GET /api/owner/sales/export", ownerGetSalesExport)
 		authedMux.HandleFunc("GET /api/owner/chairs", ownerGetChairs)
+		authedMux.HandleFunc("GET /api/owner/chairs/{chair_id}/rides", ownerGetChairRides)
+		authedMux.HandleFunc("GET /api/owner/chairs/{chair_id}/rides/{ride_id}/route", ownerGetRideRoute)
+		authedMux.HandleFunc("GET /api/owner/chairs/{chair_id}/track", ownerGetChairTrack)
+		authedMux.HandleFunc("PATCH /api/owner/chairs/{chair_id}", ownerPatchChair)
+		authedMux.HandleFunc("POST /api/owner/chairs/{chair_id}/deactivate", ownerPostChairDeactivate)
+		authedMux.HandleFunc("POST /api/owner/chairs/{chair_id}/access-token", ownerPostChairAccessToken)
+		authedMux.HandleFunc("POST /api/owner/chairs/{chair_id}/retire", ownerPostChairRetire)
+		authedMux.HandleFunc("GET /api/owner/failed-payments", ownerGetFailedPayments)
+		authedMux.HandleFunc("GET /api/owner/rides/{ride_id}/refunds", ownerGetRideRefunds)
+		authedMux.HandleFunc("POST /api/owner/rides/{ride_id}/refunds", ownerPostRideRefund)
 	}
 
 	// chair handlers
@@ -100,13 +265,20 @@ func setup() http.Handler {
 		authedMux := mux.With(chairAuthMiddleware)
 		authedMux.HandleFunc("POST /api/chair/activity", chairPostActivity)
 		authedMux.HandleFunc("POST /api/chair/coordinate", chairPostCoordinate)
-		authedMux.HandleFunc("GET /api/chair/notification", chairGetNotification)
+		authedMux.HandleFunc("GET /api/chair/notification", withEventStream(chairGetNotificationSSE, chairGetNotification))
 		authedMux.HandleFunc("POST /api/chair/rides/{ride_id}/status", chairPostRideStatus)
+		authedMux.HandleFunc("POST /api/chair/rides/{ride_id}/evaluation", chairPostRideEvaluation)
+		authedMux.HandleFunc("GET /api/chair/rides", chairGetRides)
+		authedMux.HandleFunc("GET /api/chair/earnings", chairGetEarnings)
 	}
 
 	// internal handlers
+	// マッチングはバックグラウンドでも行われるが、手動で実行するために残している
 	{
 		mux.HandleFunc("GET /api/internal/matching", internalGetMatching)
+		// クーポンキャンペーンは全てのユーザーに影響するので、オーナーではなく内部から管理する
+		mux.HandleFunc("GET /api/internal/coupon-campaigns", internalGetCouponCampaigns)
+		mux.HandleFunc("POST /api/internal/coupon-campaigns", internalPostCouponCampaigns)
 	}
 
 	return mux
@@ -133,6 +305,11 @@ func postInitialize(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if err := loadChairIndex(ctx); err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
 	if _, err := db.ExecContext(ctx, "UPDATE settings SET value = ? WHERE name = 'payment_gateway_url'", req.PaymentServer); err != nil {
 		writeError(w, http.StatusInternalServerError, err)
 		return
@@ -172,7 +349,7 @@ func writeError(w http.ResponseWriter, statusCode int, err error) {
 	}
 	w.Write(buf)
 
-	slog.Error("error response wrote", err)
+	slog.Error("error response wrote", slog.Any("error", err))
 }
 
 func secureRandomStr(b int) string {
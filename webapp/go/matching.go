@@ -0,0 +1,338 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"sync"
+	"time"
+)
+
+// rideMatcher 待機中のライドに空いている椅子を割り当てるマッチング戦略
+type rideMatcher interface {
+	// match 待機中のライドに椅子を割り当て、割り当てたライドの数を返す
+	match(ctx context.Context) (int, error)
+}
+
+const (
+	// matchingStrategyNearest 待たせている順に、配車位置へ最も早く到着できる椅子を割り当てる
+	matchingStrategyNearest = "nearest"
+	// matchingStrategyBatch 待機中の全ライドをまとめて見て、到着までの時間が短い組から割り当てる
+	matchingStrategyBatch = "batch"
+)
+
+func newRideMatcher(strategy string) (rideMatcher, error) {
+	switch strategy {
+	case "", matchingStrategyNearest:
+		return &nearestRideMatcher{}, nil
+	case matchingStrategyBatch:
+		return &batchRideMatcher{}, nil
+	default:
+		return nil, fmt.Errorf("unknown matching strategy: %s", strategy)
+	}
+}
+
+var (
+	matcher rideMatcher
+	// matchingInterval バックグラウンドでマッチングを行う間隔。0以下ならバックグラウンドでは行わない
+	matchingInterval time.Duration
+	matchingMu       sync.Mutex
+	matchingWakeCh   = make(chan struct{}, 1)
+)
+
+// runMatching マッチングを1回実行する。同時に複数のマッチングが走らないようにする
+// マッチングを始める時間になった予約のライドも、ここでマッチングの対象にする
+// 空いている椅子が割り当てられなかった相乗りを希望するライドは、相乗りできる椅子に割り当てる
+func runMatching(ctx context.Context) (int, error) {
+	matchingMu.Lock()
+	defer matchingMu.Unlock()
+	if err := promoteScheduledRides(ctx); err != nil {
+		return 0, err
+	}
+	matched, err := matcher.match(ctx)
+	if err != nil {
+		return matched, err
+	}
+	pooled, err := matchPooledRides(ctx)
+	return matched + pooled, err
+}
+
+// wakeMatching 次の間隔を待たずにバックグラウンドのマッチングを実行させる
+// ライドが作成された時や椅子が空いた時に呼ぶ
+func wakeMatching() {
+	select {
+	case matchingWakeCh <- struct{}{}:
+	default:
+		// 既に起こされているので何もしない
+	}
+}
+
+// runMatchingLoop ctxがキャンセルされるまで、一定間隔もしくは起こされる度にマッチングを行う
+func runMatchingLoop(ctx context.Context) {
+	if matchingInterval <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(matchingInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-matchingWakeCh:
+		}
+
+		if _, err := runMatching(ctx); err != nil && ctx.Err() == nil {
+			slog.Error("failed to match rides", slog.Any("error", err))
+		}
+	}
+}
+
+type waitingRide struct {
+	ID                   string    `db:"id"`
+	UserID               string    `db:"user_id"`
+	IsPooled             bool      `db:"is_pooled"`
+	PickupLatitude       int       `db:"pickup_latitude"`
+	PickupLongitude      int       `db:"pickup_longitude"`
+	DestinationLatitude  int       `db:"destination_latitude"`
+	DestinationLongitude int       `db:"destination_longitude"`
+	CreatedAt            time.Time `db:"created_at"`
+	// rejectedBy このライドを拒否した椅子のID
+	rejectedBy map[string]bool
+	// lowRated 評価の低いユーザーのライドかどうか。後回しにする
+	lowRated bool
+}
+
+type rideRejection struct {
+	RideID  string `db:"ride_id"`
+	ChairID string `db:"chair_id"`
+}
+
+// getWaitingRides 椅子が割り当てられていない、キャンセルされていないライドを待たせている順に取得する
+// 予約のライドはMATCHINGになるまで対象にしない。評価の低いユーザーのライドは後ろに回す
+func getWaitingRides(ctx context.Context) ([]waitingRide, error) {
+	rides := []waitingRide{}
+	if err := db.SelectContext(ctx, &rides, `SELECT id, user_id, is_pooled, pickup_latitude, pickup_longitude, destination_latitude, destination_longitude, created_at
+FROM rides
+WHERE chair_id IS NULL
+  AND EXISTS (SELECT 1 FROM ride_statuses WHERE ride_statuses.ride_id = rides.id AND ride_statuses.status = 'MATCHING')
+  AND NOT EXISTS (SELECT 1 FROM ride_statuses WHERE ride_statuses.ride_id = rides.id AND ride_statuses.status = 'CANCELED')
+ORDER BY created_at`); err != nil {
+		return nil, err
+	}
+
+	rejections := []rideRejection{}
+	if err := db.SelectContext(ctx, &rejections, `SELECT ride_rejections.ride_id, ride_rejections.chair_id
+FROM ride_rejections
+       INNER JOIN rides ON rides.id = ride_rejections.ride_id
+WHERE rides.chair_id IS NULL`); err != nil {
+		return nil, err
+	}
+	rejectedBy := map[string]map[string]bool{}
+	for _, rejection := range rejections {
+		if rejectedBy[rejection.RideID] == nil {
+			rejectedBy[rejection.RideID] = map[string]bool{}
+		}
+		rejectedBy[rejection.RideID][rejection.ChairID] = true
+	}
+	for i := range rides {
+		rides[i].rejectedBy = rejectedBy[rides[i].ID]
+	}
+
+	if err := markLowRatedRiders(ctx, rides); err != nil {
+		return nil, err
+	}
+
+	return rides, nil
+}
+
+type freeChair struct {
+	ID        string `db:"id"`
+	Speed     int    `db:"speed"`
+	Latitude  int    `db:"latitude"`
+	Longitude int    `db:"longitude"`
+}
+
+// getFreeChairs 稼働中で引退しておらず、進行中のライドを持たない椅子を、最新の位置情報とモデルの速度付きで取得する
+// 完了もしくはキャンセルの通知が椅子に届くまでは進行中とみなす
+// 最新の位置は、位置情報を記録する度に更新しているchair_distancesから読む
+func getFreeChairs(ctx context.Context) ([]freeChair, error) {
+	chairs := []freeChair{}
+	if err := db.SelectContext(ctx, &chairs, `SELECT chairs.id, chair_models.speed, chair_distances.latitude, chair_distances.longitude
+FROM chairs
+       INNER JOIN chair_models ON chair_models.name = chairs.model
+       INNER JOIN chair_distances ON chair_distances.chair_id = chairs.id
+WHERE chairs.is_active = TRUE
+  AND chairs.retired_at IS NULL
+  AND NOT EXISTS (SELECT 1
+                  FROM rides
+                  WHERE rides.chair_id = chairs.id
+                    AND NOT EXISTS (SELECT 1
+                                    FROM ride_statuses
+                                    WHERE ride_statuses.ride_id = rides.id
+                                      AND ride_statuses.status IN ('COMPLETED', 'CANCELED')
+                                      AND ride_statuses.chair_sent_at IS NOT NULL))
+`); err != nil {
+		return nil, err
+	}
+	return chairs, nil
+}
+
+// distanceTo 椅子の現在位置から配車位置までのマンハッタン距離
+func (c *freeChair) distanceTo(ride *waitingRide) int {
+	return calculateDistance(c.Latitude, c.Longitude, ride.PickupLatitude, ride.PickupLongitude)
+}
+
+// canTake 椅子がライドを拒否したことがなく、割り当てられるかどうか
+func (c *freeChair) canTake(ride *waitingRide) bool {
+	return !ride.rejectedBy[c.ID]
+}
+
+// pickupTime 椅子が配車位置に到着するまでにかかる時間
+func (c *freeChair) pickupTime(ride *waitingRide) int {
+	return (c.distanceTo(ride) + c.Speed - 1) / c.Speed
+}
+
+// assignRide ライドに椅子を割り当てる。既に他の椅子が割り当てられていたり、キャンセルされていた場合はfalseを返す
+func assignRide(ctx context.Context, rideID, chairID string) (bool, error) {
+	result, err := db.ExecContext(
+		ctx,
+		"UPDATE rides SET chair_id = ? WHERE id = ? AND chair_id IS NULL AND NOT EXISTS (SELECT 1 FROM ride_statuses WHERE ride_id = ? AND status = 'CANCELED')",
+		chairID, rideID, rideID,
+	)
+	if err != nil {
+		return false, err
+	}
+	count, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	if count == 0 {
+		return false, nil
+	}
+	availableChairs.addRide(chairID, rideID)
+	return true, nil
+}
+
+type nearestRideMatcher struct{}
+
+func (m *nearestRideMatcher) match(ctx context.Context) (int, error) {
+	rides, err := getWaitingRides(ctx)
+	if err != nil || len(rides) == 0 {
+		return 0, err
+	}
+	chairs, err := getFreeChairs(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	matched := 0
+	for _, ride := range rides {
+		if len(chairs) == 0 {
+			break
+		}
+
+		nearest := -1
+		for i := range chairs {
+			if !chairs[i].canTake(&ride) {
+				continue
+			}
+			if nearest < 0 {
+				nearest = i
+				continue
+			}
+			t, nt := chairs[i].pickupTime(&ride), chairs[nearest].pickupTime(&ride)
+			if t < nt || (t == nt && chairs[i].distanceTo(&ride) < chairs[nearest].distanceTo(&ride)) {
+				nearest = i
+			}
+		}
+		if nearest < 0 {
+			// 割り当てられる椅子が無いので次のライドを見る
+			continue
+		}
+
+		ok, err := assignRide(ctx, ride.ID, chairs[nearest].ID)
+		if err != nil {
+			return matched, err
+		}
+		if ok {
+			matched++
+			chairs = slices.Delete(chairs, nearest, nearest+1)
+		}
+	}
+
+	return matched, nil
+}
+
+type batchRideMatcher struct{}
+
+type matchingCandidate struct {
+	ride       *waitingRide
+	chair      *freeChair
+	pickupTime int
+	distance   int
+}
+
+func (m *batchRideMatcher) match(ctx context.Context) (int, error) {
+	rides, err := getWaitingRides(ctx)
+	if err != nil || len(rides) == 0 {
+		return 0, err
+	}
+	chairs, err := getFreeChairs(ctx)
+	if err != nil || len(chairs) == 0 {
+		return 0, err
+	}
+
+	candidates := make([]matchingCandidate, 0, len(rides)*len(chairs))
+	for i := range rides {
+		for j := range chairs {
+			if !chairs[j].canTake(&rides[i]) {
+				continue
+			}
+			candidates = append(candidates, matchingCandidate{
+				ride:       &rides[i],
+				chair:      &chairs[j],
+				pickupTime: chairs[j].pickupTime(&rides[i]),
+				distance:   chairs[j].distanceTo(&rides[i]),
+			})
+		}
+	}
+	// 到着までの時間が短い組から順に確定させる。同じなら待たせているライドを優先する
+	// 評価の低いユーザーのライドは、他のライドの組を全て確定させてから見る
+	slices.SortStableFunc(candidates, func(a, b matchingCandidate) int {
+		if c := compareLowRated(a.ride, b.ride); c != 0 {
+			return c
+		}
+		if a.pickupTime != b.pickupTime {
+			return a.pickupTime - b.pickupTime
+		}
+		if a.distance != b.distance {
+			return a.distance - b.distance
+		}
+		return a.ride.CreatedAt.Compare(b.ride.CreatedAt)
+	})
+
+	assignedRides := map[string]bool{}
+	assignedChairs := map[string]bool{}
+	for _, c := range candidates {
+		if assignedRides[c.ride.ID] || assignedChairs[c.chair.ID] {
+			continue
+		}
+		ok, err := assignRide(ctx, c.ride.ID, c.chair.ID)
+		if err != nil {
+			return len(assignedChairs), err
+		}
+		// 他で割り当て済みのライドは候補から外す
+		assignedRides[c.ride.ID] = true
+		if ok {
+			assignedChairs[c.chair.ID] = true
+		}
+		if len(assignedChairs) == len(chairs) {
+			break
+		}
+	}
+
+	return len(assignedChairs), nil
+}
@@ -6,14 +6,15 @@ import (
 )
 
 type Chair struct {
-	ID          string    `db:"id"`
-	OwnerID     string    `db:"owner_id"`
-	Name        string    `db:"name"`
-	Model       string    `db:"model"`
-	IsActive    bool      `db:"is_active"`
-	AccessToken string    `db:"access_token"`
-	CreatedAt   time.Time `db:"created_at"`
-	UpdatedAt   time.Time `db:"updated_at"`
+	ID          string     `db:"id"`
+	OwnerID     string     `db:"owner_id"`
+	Name        string     `db:"name"`
+	Model       string     `db:"model"`
+	IsActive    bool       `db:"is_active"`
+	AccessToken string     `db:"access_token"`
+	RetiredAt   *time.Time `db:"retired_at"`
+	CreatedAt   time.Time  `db:"created_at"`
+	UpdatedAt   time.Time  `db:"updated_at"`
 }
 
 type ChairModel struct {
@@ -42,8 +43,10 @@ type User struct {
 }
 
 type PaymentToken struct {
+	ID        string    `db:"id"`
 	UserID    string    `db:"user_id"`
 	Token     string    `db:"token"`
+	IsDefault bool      `db:"is_default"`
 	CreatedAt time.Time `db:"created_at"`
 }
 
@@ -55,7 +58,18 @@ type Ride struct {
 	PickupLongitude      int            `db:"pickup_longitude"`
 	DestinationLatitude  int            `db:"destination_latitude"`
 	DestinationLongitude int            `db:"destination_longitude"`
+	ScheduledAt          *time.Time     `db:"scheduled_at"`
+	IsPooled             bool           `db:"is_pooled"`
+	SurgeRate            int            `db:"surge_rate"`
+	BaseFare             int            `db:"base_fare"`
+	MeteredFare          int            `db:"metered_fare"`
+	Discount             int            `db:"discount"`
+	Fare                 int            `db:"fare"`
+	Currency             string         `db:"currency"`
+	PaymentTokenID       sql.NullString `db:"payment_token_id"`
+	Tip                  int            `db:"tip"`
 	Evaluation           *int           `db:"evaluation"`
+	RiderEvaluation      *int           `db:"rider_evaluation"`
 	CreatedAt            time.Time      `db:"created_at"`
 	UpdatedAt            time.Time      `db:"updated_at"`
 }
@@ -69,6 +83,21 @@ type RideStatus struct {
 	ChairSentAt *time.Time `db:"chair_sent_at"`
 }
 
+type RideStop struct {
+	RideID    string     `db:"ride_id"`
+	StopOrder int        `db:"stop_order"`
+	Latitude  int        `db:"latitude"`
+	Longitude int        `db:"longitude"`
+	ArrivedAt *time.Time `db:"arrived_at"`
+	CreatedAt time.Time  `db:"created_at"`
+}
+
+type RideCancellation struct {
+	RideID    string    `db:"ride_id"`
+	Fee       int       `db:"fee"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
 type Owner struct {
 	ID                 string    `db:"id"`
 	Name               string    `db:"name"`
@@ -79,9 +108,52 @@ type Owner struct {
 }
 
 type Coupon struct {
-	UserID    string    `db:"user_id"`
-	Code      string    `db:"code"`
-	Discount  int       `db:"discount"`
-	CreatedAt time.Time `db:"created_at"`
-	UsedBy    *string   `db:"used_by"`
+	UserID     string    `db:"user_id"`
+	Code       string    `db:"code"`
+	CampaignID *string   `db:"campaign_id"`
+	Discount   int       `db:"discount"`
+	CreatedAt  time.Time `db:"created_at"`
+	UsedBy     *string   `db:"used_by"`
+}
+
+type CouponCampaign struct {
+	ID                 string     `db:"id"`
+	Kind               string     `db:"kind"`
+	CodePrefix         string     `db:"code_prefix"`
+	DiscountAmount     *int       `db:"discount_amount"`
+	DiscountPercentage *int       `db:"discount_percentage"`
+	StartsAt           *time.Time `db:"starts_at"`
+	EndsAt             *time.Time `db:"ends_at"`
+	PerUserLimit       *int       `db:"per_user_limit"`
+	TotalLimit         *int       `db:"total_limit"`
+	Priority           int        `db:"priority"`
+	CreatedAt          time.Time  `db:"created_at"`
+}
+
+type Payment struct {
+	ID             string    `db:"id"`
+	RideID         string    `db:"ride_id"`
+	Kind           string    `db:"kind"`
+	IdempotencyKey string    `db:"idempotency_key"`
+	Amount         int       `db:"amount"`
+	Status         string    `db:"status"`
+	Attempts       int       `db:"attempts"`
+	LastError      *string   `db:"last_error"`
+	NextAttemptAt  time.Time `db:"next_attempt_at"`
+	CreatedAt      time.Time `db:"created_at"`
+	UpdatedAt      time.Time `db:"updated_at"`
+}
+
+type PaymentRefund struct {
+	ID             string    `db:"id"`
+	PaymentID      string    `db:"payment_id"`
+	IdempotencyKey string    `db:"idempotency_key"`
+	Amount         int       `db:"amount"`
+	Reason         *string   `db:"reason"`
+	Status         string    `db:"status"`
+	Attempts       int       `db:"attempts"`
+	LastError      *string   `db:"last_error"`
+	NextAttemptAt  time.Time `db:"next_attempt_at"`
+	CreatedAt      time.Time `db:"created_at"`
+	UpdatedAt      time.Time `db:"updated_at"`
 }
@@ -0,0 +1,239 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"net/http"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// オーナーが管理している椅子の名前やモデルを変更したり、停止・引退させたりする
+// 引退した椅子はマッチングや周辺の椅子の検索の対象にならず、再び稼働させることもできないが、売上やライドの履歴は残る
+
+var (
+	errChairNotFound = errors.New("chair not found")
+	errRetiredChair  = errors.New("chair is retired")
+)
+
+// getOwnerChairForUpdate オーナーが管理している椅子をロックして取得する
+func getOwnerChairForUpdate(ctx context.Context, tx *sqlx.Tx, ownerID, chairID string) (*Chair, error) {
+	chair := &Chair{}
+	if err := tx.GetContext(ctx, chair, `SELECT * FROM chairs WHERE id = ? AND owner_id = ? FOR UPDATE`, chairID, ownerID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errChairNotFound
+		}
+		return nil, err
+	}
+	return chair, nil
+}
+
+// writeOwnerChairError 椅子の取得に失敗した時のレスポンスを返す
+func writeOwnerChairError(w http.ResponseWriter, err error) {
+	if errors.Is(err, errChairNotFound) {
+		writeError(w, http.StatusNotFound, err)
+		return
+	}
+	writeError(w, http.StatusInternalServerError, err)
+}
+
+type ownerPatchChairRequest struct {
+	Name  *string `json:"name"`
+	Model *string `json:"model"`
+}
+
+// ownerPatchChair オーナーが椅子の名前とモデルを変更する。指定されなかった項目は変更しない
+func ownerPatchChair(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	owner := ctx.Value("owner").(*Owner)
+	chairID := r.PathValue("chair_id")
+
+	req := &ownerPatchChairRequest{}
+	if err := bindJSON(r, req); err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	if (req.Name != nil && *req.Name == "") || (req.Model != nil && *req.Model == "") {
+		writeError(w, http.StatusBadRequest, errors.New("name and model must not be empty"))
+		return
+	}
+
+	tx, err := db.Beginx()
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	defer tx.Rollback()
+
+	chair, err := getOwnerChairForUpdate(ctx, tx, owner.ID, chairID)
+	if err != nil {
+		writeOwnerChairError(w, err)
+		return
+	}
+	if chair.RetiredAt != nil {
+		writeError(w, http.StatusBadRequest, errRetiredChair)
+		return
+	}
+
+	if req.Name != nil {
+		chair.Name = *req.Name
+	}
+	if req.Model != nil {
+		// マッチングではモデルから椅子の速度を求めるので、登録されているモデルにしか変更できない
+		var count int
+		if err := tx.GetContext(ctx, &count, `SELECT COUNT(*) FROM chair_models WHERE name = ?`, *req.Model); err != nil {
+			writeError(w, http.StatusInternalServerError, err)
+			return
+		}
+		if count == 0 {
+			writeError(w, http.StatusBadRequest, errors.New("unknown chair model"))
+			return
+		}
+		chair.Model = *req.Model
+	}
+
+	if _, err := tx.ExecContext(ctx, `UPDATE chairs SET name = ?, model = ? WHERE id = ?`, chair.Name, chair.Model, chair.ID); err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	if err := tx.Commit(); err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	availableChairs.putChair(chair)
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ownerPostChairDeactivate オーナーが椅子を停止させる。進行中のライドはそのまま続けられる
+func ownerPostChairDeactivate(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	owner := ctx.Value("owner").(*Owner)
+	chairID := r.PathValue("chair_id")
+
+	tx, err := db.Beginx()
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	defer tx.Rollback()
+
+	chair, err := getOwnerChairForUpdate(ctx, tx, owner.ID, chairID)
+	if err != nil {
+		writeOwnerChairError(w, err)
+		return
+	}
+
+	if _, err := tx.ExecContext(ctx, `UPDATE chairs SET is_active = FALSE WHERE id = ?`, chair.ID); err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	if err := tx.Commit(); err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	availableChairs.setActive(chair.ID, false)
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+type ownerPostChairAccessTokenResponse struct {
+	AccessToken string `json:"access_token"`
+}
+
+// ownerPostChairAccessToken オーナーが椅子のアクセストークンを再発行する。以前のアクセストークンは使えなくなる
+func ownerPostChairAccessToken(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	owner := ctx.Value("owner").(*Owner)
+	chairID := r.PathValue("chair_id")
+
+	tx, err := db.Beginx()
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	defer tx.Rollback()
+
+	chair, err := getOwnerChairForUpdate(ctx, tx, owner.ID, chairID)
+	if err != nil {
+		writeOwnerChairError(w, err)
+		return
+	}
+	if chair.RetiredAt != nil {
+		writeError(w, http.StatusBadRequest, errRetiredChair)
+		return
+	}
+
+	accessToken := secureRandomStr(32)
+	if _, err := tx.ExecContext(ctx, `UPDATE chairs SET access_token = ? WHERE id = ?`, accessToken, chair.ID); err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	if err := tx.Commit(); err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, &ownerPostChairAccessTokenResponse{
+		AccessToken: accessToken,
+	})
+}
+
+// ownerPostChairRetire オーナーが椅子を引退させる。進行中のライドがある椅子は引退させられない
+func ownerPostChairRetire(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	owner := ctx.Value("owner").(*Owner)
+	chairID := r.PathValue("chair_id")
+
+	tx, err := db.Beginx()
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	defer tx.Rollback()
+
+	chair, err := getOwnerChairForUpdate(ctx, tx, owner.ID, chairID)
+	if err != nil {
+		writeOwnerChairError(w, err)
+		return
+	}
+	if chair.RetiredAt != nil {
+		writeError(w, http.StatusBadRequest, errRetiredChair)
+		return
+	}
+
+	// 進行中かどうかはgetFreeChairsと同じく、完了もしくはキャンセルの通知が椅子に届いたかで判断する
+	var unfinishedRides int
+	if err := tx.GetContext(ctx, &unfinishedRides, `SELECT COUNT(*)
+FROM rides
+WHERE chair_id = ?
+  AND NOT EXISTS (SELECT 1
+                  FROM ride_statuses
+                  WHERE ride_statuses.ride_id = rides.id
+                    AND ride_statuses.status IN ('COMPLETED', 'CANCELED')
+                    AND ride_statuses.chair_sent_at IS NOT NULL)`, chair.ID); err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	if unfinishedRides > 0 {
+		writeError(w, http.StatusConflict, errors.New("chair has unfinished rides"))
+		return
+	}
+
+	if _, err := tx.ExecContext(ctx, `UPDATE chairs SET is_active = FALSE, retired_at = CURRENT_TIMESTAMP(6) WHERE id = ?`, chair.ID); err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	if err := tx.Commit(); err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	availableChairs.setActive(chair.ID, false)
+
+	w.WriteHeader(http.StatusNoContent)
+}
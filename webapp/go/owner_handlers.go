@@ -4,7 +4,6 @@ import (
 	"database/sql"
 	"errors"
 	"net/http"
-	"strconv"
 	"time"
 
 	"github.com/oklog/ulid/v2"
@@ -13,6 +12,10 @@ import (
 const (
 	initialFare     = 500
 	farePerDistance = 100
+	// fareCurrency 運賃の通貨
+	fareCurrency = "JPY"
+	// cancellationFee 椅子が配車位置へ向かい始めた後にユーザーがキャンセルした場合の料金
+	cancellationFee = 500
 )
 
 type ownerPostOwnersRequest struct {
@@ -66,38 +69,29 @@ type chairSales struct {
 	ID    string `json:"id"`
 	Name  string `json:"name"`
 	Sales int    `json:"sales"`
+	Tips  int    `json:"tips"`
 }
 
 type modelSales struct {
 	Model string `json:"model"`
 	Sales int    `json:"sales"`
+	Tips  int    `json:"tips"`
 }
 
 type ownerGetSalesResponse struct {
-	TotalSales int          `json:"total_sales"`
-	Chairs     []chairSales `json:"chairs"`
-	Models     []modelSales `json:"models"`
+	TotalSales int `json:"total_sales"`
+	// TotalTips チップの合計。売上には含めない
+	TotalTips int          `json:"total_tips"`
+	Chairs    []chairSales `json:"chairs"`
+	Models    []modelSales `json:"models"`
 }
 
 func ownerGetSales(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
-	since := time.Unix(0, 0)
-	until := time.Date(9999, 12, 31, 23, 59, 59, 0, time.UTC)
-	if r.URL.Query().Get("since") != "" {
-		parsed, err := strconv.ParseInt(r.URL.Query().Get("since"), 10, 64)
-		if err != nil {
-			writeError(w, http.StatusBadRequest, err)
-			return
-		}
-		since = time.UnixMilli(parsed)
-	}
-	if r.URL.Query().Get("until") != "" {
-		parsed, err := strconv.ParseInt(r.URL.Query().Get("until"), 10, 64)
-		if err != nil {
-			writeError(w, http.StatusBadRequest, err)
-			return
-		}
-		until = time.UnixMilli(parsed)
+	since, until, err := parsePeriod(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
 	}
 
 	owner := r.Context().Value("owner").(*Owner)
@@ -119,24 +113,29 @@ func ownerGetSales(w http.ResponseWriter, r *http.Request) {
 		TotalSales: 0,
 	}
 
+	earnings, err := getOwnerChairEarnings(ctx, tx, owner.ID, since, until)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
 	modelSalesByModel := map[string]int{}
+	modelTipsByModel := map[string]int{}
 	for _, chair := range chairs {
-		rides := []Ride{}
-		if err := tx.SelectContext(ctx, &rides, "SELECT rides.* FROM rides JOIN ride_statuses ON rides.id = ride_statuses.ride_id WHERE chair_id = ? AND status = 'COMPLETED' AND updated_at BETWEEN ? AND ? + INTERVAL 999 MICROSECOND", chair.ID, since, until); err != nil {
-			writeError(w, http.StatusInternalServerError, err)
-			return
-		}
-
-		sales := sumSales(rides)
+		sales := earnings[chair.ID].total()
+		tips := earnings[chair.ID].Tips
 		res.TotalSales += sales
+		res.TotalTips += tips
 
 		res.Chairs = append(res.Chairs, chairSales{
 			ID:    chair.ID,
 			Name:  chair.Name,
 			Sales: sales,
+			Tips:  tips,
 		})
 
 		modelSalesByModel[chair.Model] += sales
+		modelTipsByModel[chair.Model] += tips
 	}
 
 	models := []modelSales{}
@@ -144,6 +143,7 @@ func ownerGetSales(w http.ResponseWriter, r *http.Request) {
 		models = append(models, modelSales{
 			Model: model,
 			Sales: sales,
+			Tips:  modelTipsByModel[model],
 		})
 	}
 	res.Models = models
@@ -151,16 +151,11 @@ func ownerGetSales(w http.ResponseWriter, r *http.Request) {
 	writeJSON(w, http.StatusOK, res)
 }
 
-func sumSales(rides []Ride) int {
-	sale := 0
-	for _, ride := range rides {
-		sale += calculateSale(ride)
-	}
-	return sale
-}
-
+// calculateSale クーポンで割り引く前の運賃を売上とする
+// 相乗りの割引は運賃そのものを下げるので売上にも反映し、相乗りしたライドはそれぞれ運んだ椅子の売上になる
+// 売上をまとめて集計するクエリでも同じ式(saleExpression)を使っているので、変える時は合わせて変える
 func calculateSale(ride Ride) int {
-	return calculateFare(ride.PickupLatitude, ride.PickupLongitude, ride.DestinationLatitude, ride.DestinationLongitude)
+	return ride.BaseFare + ride.MeteredFare
 }
 
 type chairWithDetail struct {
@@ -170,6 +165,7 @@ type chairWithDetail struct {
 	AccessToken            string       `db:"access_token"`
 	Model                  string       `db:"model"`
 	IsActive               bool         `db:"is_active"`
+	RetiredAt              sql.NullTime `db:"retired_at"`
 	CreatedAt              time.Time    `db:"created_at"`
 	UpdatedAt              time.Time    `db:"updated_at"`
 	TotalDistance          int          `db:"total_distance"`
@@ -188,6 +184,7 @@ type ownerGetChairResponseChair struct {
 	RegisteredAt           int64  `json:"registered_at"`
 	TotalDistance          int    `json:"total_distance"`
 	TotalDistanceUpdatedAt *int64 `json:"total_distance_updated_at,omitempty"`
+	RetiredAt              *int64 `json:"retired_at,omitempty"`
 }
 
 func ownerGetChairs(w http.ResponseWriter, r *http.Request) {
@@ -195,27 +192,20 @@ func ownerGetChairs(w http.ResponseWriter, r *http.Request) {
 	owner := ctx.Value("owner").(*Owner)
 
 	chairs := []chairWithDetail{}
-	if err := db.SelectContext(ctx, &chairs, `SELECT id,
-       owner_id,
-       name,
-       access_token,
-       model,
-       is_active,
-       created_at,
-       updated_at,
-       IFNULL(total_distance, 0) AS total_distance,
-       total_distance_updated_at
+	if err := db.SelectContext(ctx, &chairs, `SELECT chairs.id,
+       chairs.owner_id,
+       chairs.name,
+       chairs.access_token,
+       chairs.model,
+       chairs.is_active,
+       chairs.retired_at,
+       chairs.created_at,
+       chairs.updated_at,
+       IFNULL(chair_distances.total_distance, 0) AS total_distance,
+       chair_distances.updated_at AS total_distance_updated_at
 FROM chairs
-       LEFT JOIN (SELECT chair_id,
-                          SUM(IFNULL(distance, 0)) AS total_distance,
-                          MAX(created_at)          AS total_distance_updated_at
-                   FROM (SELECT chair_id,
-                                created_at,
-                                ABS(latitude - LAG(latitude) OVER (PARTITION BY chair_id ORDER BY created_at)) +
-                                ABS(longitude - LAG(longitude) OVER (PARTITION BY chair_id ORDER BY created_at)) AS distance
-                         FROM chair_locations) tmp
-                   GROUP BY chair_id) distance_table ON distance_table.chair_id = chairs.id
-WHERE owner_id = ?
+       LEFT JOIN chair_distances ON chair_distances.chair_id = chairs.id
+WHERE chairs.owner_id = ?
 `, owner.ID); err != nil {
 		writeError(w, http.StatusInternalServerError, err)
 		return
@@ -235,7 +225,68 @@ WHERE owner_id = ?
 			t := chair.TotalDistanceUpdatedAt.Time.UnixMilli()
 			c.TotalDistanceUpdatedAt = &t
 		}
+		if chair.RetiredAt.Valid {
+			t := chair.RetiredAt.Time.UnixMilli()
+			c.RetiredAt = &t
+		}
 		res.Chairs = append(res.Chairs, c)
 	}
 	writeJSON(w, http.StatusOK, res)
 }
+
+type paymentWithChair struct {
+	Payment
+	ChairID   string `db:"chair_id"`
+	ChairName string `db:"chair_name"`
+}
+
+type ownerGetFailedPaymentsResponse struct {
+	Payments []ownerGetFailedPaymentsResponsePayment `json:"payments"`
+}
+
+type ownerGetFailedPaymentsResponsePayment struct {
+	RideID    string  `json:"ride_id"`
+	ChairID   string  `json:"chair_id"`
+	ChairName string  `json:"chair_name"`
+	Kind      string  `json:"kind"`
+	Amount    int     `json:"amount"`
+	Status    string  `json:"status"`
+	Attempts  int     `json:"attempts"`
+	LastError *string `json:"last_error,omitempty"`
+	UpdatedAt int64   `json:"updated_at"`
+}
+
+// ownerGetFailedPayments リトライし尽くした、もしくは拒否されて決済できていないライドの一覧を返す
+func ownerGetFailedPayments(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	owner := ctx.Value("owner").(*Owner)
+
+	payments := []paymentWithChair{}
+	if err := db.SelectContext(ctx, &payments, `SELECT payments.*, chairs.id AS chair_id, chairs.name AS chair_name
+FROM payments
+       INNER JOIN rides ON rides.id = payments.ride_id
+       INNER JOIN chairs ON chairs.id = rides.chair_id
+WHERE chairs.owner_id = ?
+  AND payments.status IN ('FAILED', 'REJECTED')
+ORDER BY payments.updated_at DESC
+`, owner.ID); err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	res := ownerGetFailedPaymentsResponse{Payments: []ownerGetFailedPaymentsResponsePayment{}}
+	for _, payment := range payments {
+		res.Payments = append(res.Payments, ownerGetFailedPaymentsResponsePayment{
+			RideID:    payment.RideID,
+			ChairID:   payment.ChairID,
+			ChairName: payment.ChairName,
+			Kind:      payment.Kind,
+			Amount:    payment.Amount,
+			Status:    payment.Status,
+			Attempts:  payment.Attempts,
+			LastError: payment.LastError,
+			UpdatedAt: payment.UpdatedAt.UnixMilli(),
+		})
+	}
+	writeJSON(w, http.StatusOK, res)
+}
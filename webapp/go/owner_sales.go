@@ -0,0 +1,516 @@
+package main
+
+import (
+	"cmp"
+	"context"
+	"database/sql"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// オーナーの売上を期間ごとに集計したり、会計処理のためにライド単位で書き出したりする
+// 椅子の数が多くても時間がかからないように、椅子ごとにクエリを発行せずにまとめて集計する
+
+// saleExpression ライドの売上を求める式。calculateSaleと同じ
+const saleExpression = "rides.base_fare + rides.metered_fare"
+
+// getOwnerChairEarnings オーナーが管理している椅子の期間中の売上を椅子ごとに求める。売上が無い椅子は含まれない
+func getOwnerChairEarnings(ctx context.Context, tx *sqlx.Tx, ownerID string, since, until time.Time) (map[string]chairEarnings, error) {
+	return queryChairEarnings(ctx, tx, "chairs.owner_id = ?", ownerID, since, until)
+}
+
+// getChairEarnings 椅子の期間中の売上を求める
+func getChairEarnings(ctx context.Context, tx *sqlx.Tx, chairID string, since, until time.Time) (chairEarnings, error) {
+	earnings, err := queryChairEarnings(ctx, tx, "rides.chair_id = ?", chairID, since, until)
+	if err != nil {
+		return chairEarnings{}, err
+	}
+	return earnings[chairID], nil
+}
+
+// queryChairEarnings conditionに一致する椅子の期間中の売上を椅子ごとに求める。conditionはridesとchairsのカラムで椅子を絞り込む条件で、argをその引数にする
+func queryChairEarnings(ctx context.Context, tx *sqlx.Tx, condition string, arg any, since, until time.Time) (map[string]chairEarnings, error) {
+	type rideSalesRow struct {
+		ChairID        string `db:"chair_id"`
+		RideSales      int    `db:"ride_sales"`
+		Tips           int    `db:"tips"`
+		CompletedRides int    `db:"completed_rides"`
+	}
+	rideSales := []rideSalesRow{}
+	if err := tx.SelectContext(ctx, &rideSales, `SELECT rides.chair_id, SUM(`+saleExpression+`) AS ride_sales, SUM(rides.tip) AS tips, COUNT(*) AS completed_rides
+FROM rides
+       INNER JOIN chairs ON chairs.id = rides.chair_id
+       INNER JOIN ride_statuses ON ride_statuses.ride_id = rides.id
+WHERE `+condition+`
+  AND ride_statuses.status = 'COMPLETED'
+  AND rides.updated_at BETWEEN ? AND ? + INTERVAL 999 MICROSECOND
+GROUP BY rides.chair_id`, arg, since, until); err != nil {
+		return nil, err
+	}
+
+	type cancellationFeesRow struct {
+		ChairID          string `db:"chair_id"`
+		CancellationFees int    `db:"cancellation_fees"`
+	}
+	cancellationFees := []cancellationFeesRow{}
+	if err := tx.SelectContext(ctx, &cancellationFees, `SELECT rides.chair_id, SUM(ride_cancellations.fee) AS cancellation_fees
+FROM ride_cancellations
+       INNER JOIN rides ON rides.id = ride_cancellations.ride_id
+       INNER JOIN chairs ON chairs.id = rides.chair_id
+WHERE `+condition+`
+  AND ride_cancellations.created_at BETWEEN ? AND ? + INTERVAL 999 MICROSECOND
+GROUP BY rides.chair_id`, arg, since, until); err != nil {
+		return nil, err
+	}
+
+	type refundsRow struct {
+		ChairID string `db:"chair_id"`
+		Refunds int    `db:"refunds"`
+	}
+	refunds := []refundsRow{}
+	if err := tx.SelectContext(ctx, &refunds, `SELECT rides.chair_id, SUM(payment_refunds.amount) AS refunds
+FROM payment_refunds
+       INNER JOIN payments ON payments.id = payment_refunds.payment_id
+       INNER JOIN rides ON rides.id = payments.ride_id
+       INNER JOIN chairs ON chairs.id = rides.chair_id
+WHERE `+condition+`
+  AND payment_refunds.status = 'SUCCEEDED'
+  AND payment_refunds.created_at BETWEEN ? AND ? + INTERVAL 999 MICROSECOND
+GROUP BY rides.chair_id`, arg, since, until); err != nil {
+		return nil, err
+	}
+
+	earnings := map[string]chairEarnings{}
+	for _, row := range rideSales {
+		e := earnings[row.ChairID]
+		e.RideSales = row.RideSales
+		e.Tips = row.Tips
+		e.CompletedRides = row.CompletedRides
+		earnings[row.ChairID] = e
+	}
+	for _, row := range cancellationFees {
+		e := earnings[row.ChairID]
+		e.CancellationFees = row.CancellationFees
+		earnings[row.ChairID] = e
+	}
+	for _, row := range refunds {
+		e := earnings[row.ChairID]
+		e.Refunds = row.Refunds
+		earnings[row.ChairID] = e
+	}
+	return earnings, nil
+}
+
+const (
+	salesBucketHour = "hour"
+	salesBucketDay  = "day"
+	salesBucketWeek = "week"
+)
+
+// salesBucketExpression 日時のカラムを、その日時を含む集計単位の開始日時にする式を返す。週は月曜日から始まる
+func salesBucketExpression(bucket, column string) (string, error) {
+	switch bucket {
+	case salesBucketHour:
+		return "CAST(DATE_FORMAT(" + column + ", '%Y-%m-%d %H:00:00') AS DATETIME)", nil
+	case "", salesBucketDay:
+		return "CAST(DATE(" + column + ") AS DATETIME)", nil
+	case salesBucketWeek:
+		return "CAST(DATE(" + column + ") - INTERVAL WEEKDAY(" + column + ") DAY AS DATETIME)", nil
+	default:
+		return "", fmt.Errorf("unknown bucket: %s", bucket)
+	}
+}
+
+// salesPoint 集計単位ごとの売上
+type salesPoint struct {
+	Start int64 `json:"start"`
+	Sales int   `json:"sales"`
+	Rides int   `json:"rides"`
+	// AverageEvaluation 完了したライドの評価の平均。完了したライドが無ければ含まれない
+	AverageEvaluation *float64 `json:"average_evaluation,omitempty"`
+
+	evaluationSum int
+}
+
+type chairSalesSeries struct {
+	ID     string        `json:"id"`
+	Name   string        `json:"name"`
+	Model  string        `json:"model"`
+	Series []*salesPoint `json:"series"`
+}
+
+type modelSalesSeries struct {
+	Model  string        `json:"model"`
+	Series []*salesPoint `json:"series"`
+}
+
+type ownerGetSalesTimeseriesResponse struct {
+	Bucket string             `json:"bucket"`
+	Chairs []chairSalesSeries `json:"chairs"`
+	Models []modelSalesSeries `json:"models"`
+}
+
+type salesBucketRow struct {
+	ChairID       string    `db:"chair_id"`
+	Start         time.Time `db:"bucket_start"`
+	Sales         int       `db:"sales"`
+	Rides         int       `db:"rides"`
+	EvaluationSum int       `db:"evaluation_sum"`
+}
+
+// salesSeriesBuilder 集計単位ごとの売上を開始日時順に積み上げる
+type salesSeriesBuilder struct {
+	points map[int64]*salesPoint
+}
+
+func (b *salesSeriesBuilder) add(row salesBucketRow) {
+	if b.points == nil {
+		b.points = map[int64]*salesPoint{}
+	}
+	start := row.Start.UnixMilli()
+	p, ok := b.points[start]
+	if !ok {
+		p = &salesPoint{Start: start}
+		b.points[start] = p
+	}
+	p.Sales += row.Sales
+	p.Rides += row.Rides
+	p.evaluationSum += row.EvaluationSum
+}
+
+func (b *salesSeriesBuilder) build() []*salesPoint {
+	series := make([]*salesPoint, 0, len(b.points))
+	for _, p := range b.points {
+		if p.Rides > 0 {
+			avg := float64(p.evaluationSum) / float64(p.Rides)
+			p.AverageEvaluation = &avg
+		}
+		series = append(series, p)
+	}
+	slices.SortFunc(series, func(a, b *salesPoint) int { return cmp.Compare(a.Start, b.Start) })
+	return series
+}
+
+// ownerGetSalesTimeseries オーナーが管理している椅子の売上・完了したライドの数・評価の平均を、集計単位ごとに椅子別・モデル別で取得する
+// 売上もライドも無い集計単位は含まれない
+func ownerGetSalesTimeseries(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	owner := ctx.Value("owner").(*Owner)
+
+	since, until, err := parsePeriod(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	bucket := r.URL.Query().Get("bucket")
+	completedBucket, err := salesBucketExpression(bucket, "rides.updated_at")
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	canceledBucket, _ := salesBucketExpression(bucket, "ride_cancellations.created_at")
+	refundedBucket, _ := salesBucketExpression(bucket, "payment_refunds.created_at")
+	if bucket == "" {
+		bucket = salesBucketDay
+	}
+
+	tx, err := db.Beginx()
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	defer tx.Rollback()
+
+	chairs := []Chair{}
+	if err := tx.SelectContext(ctx, &chairs, "SELECT * FROM chairs WHERE owner_id = ? ORDER BY created_at, id", owner.ID); err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	rows := []salesBucketRow{}
+	if err := tx.SelectContext(ctx, &rows, `SELECT rides.chair_id,
+       `+completedBucket+` AS bucket_start,
+       SUM(`+saleExpression+`) AS sales,
+       COUNT(*) AS rides,
+       IFNULL(SUM(rides.evaluation), 0) AS evaluation_sum
+FROM rides
+       INNER JOIN chairs ON chairs.id = rides.chair_id
+       INNER JOIN ride_statuses ON ride_statuses.ride_id = rides.id
+WHERE chairs.owner_id = ?
+  AND ride_statuses.status = 'COMPLETED'
+  AND rides.updated_at BETWEEN ? AND ? + INTERVAL 999 MICROSECOND
+GROUP BY rides.chair_id, bucket_start`, owner.ID, since, until); err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	// キャンセル料は売上に含めるが、完了したライドの数や評価には含めない
+	cancellations := []salesBucketRow{}
+	if err := tx.SelectContext(ctx, &cancellations, `SELECT rides.chair_id,
+       `+canceledBucket+` AS bucket_start,
+       SUM(ride_cancellations.fee) AS sales,
+       0 AS rides,
+       0 AS evaluation_sum
+FROM ride_cancellations
+       INNER JOIN rides ON rides.id = ride_cancellations.ride_id
+       INNER JOIN chairs ON chairs.id = rides.chair_id
+WHERE chairs.owner_id = ?
+  AND ride_cancellations.created_at BETWEEN ? AND ? + INTERVAL 999 MICROSECOND
+GROUP BY rides.chair_id, bucket_start`, owner.ID, since, until); err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	// 返金は返金した集計単位の売上から差し引く
+	refunds := []salesBucketRow{}
+	if err := tx.SelectContext(ctx, &refunds, `SELECT rides.chair_id,
+       `+refundedBucket+` AS bucket_start,
+       -SUM(payment_refunds.amount) AS sales,
+       0 AS rides,
+       0 AS evaluation_sum
+FROM payment_refunds
+       INNER JOIN payments ON payments.id = payment_refunds.payment_id
+       INNER JOIN rides ON rides.id = payments.ride_id
+       INNER JOIN chairs ON chairs.id = rides.chair_id
+WHERE chairs.owner_id = ?
+  AND payment_refunds.status = 'SUCCEEDED'
+  AND payment_refunds.created_at BETWEEN ? AND ? + INTERVAL 999 MICROSECOND
+GROUP BY rides.chair_id, bucket_start`, owner.ID, since, until); err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	if err := tx.Commit(); err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	chairModels := map[string]string{}
+	for _, chair := range chairs {
+		chairModels[chair.ID] = chair.Model
+	}
+	chairBuilders := map[string]*salesSeriesBuilder{}
+	modelBuilders := map[string]*salesSeriesBuilder{}
+	for _, row := range slices.Concat(rows, cancellations, refunds) {
+		model := chairModels[row.ChairID]
+		if chairBuilders[row.ChairID] == nil {
+			chairBuilders[row.ChairID] = &salesSeriesBuilder{}
+		}
+		if modelBuilders[model] == nil {
+			modelBuilders[model] = &salesSeriesBuilder{}
+		}
+		chairBuilders[row.ChairID].add(row)
+		modelBuilders[model].add(row)
+	}
+
+	res := ownerGetSalesTimeseriesResponse{
+		Bucket: bucket,
+		Chairs: []chairSalesSeries{},
+		Models: []modelSalesSeries{},
+	}
+	for _, chair := range chairs {
+		builder := chairBuilders[chair.ID]
+		if builder == nil {
+			builder = &salesSeriesBuilder{}
+		}
+		res.Chairs = append(res.Chairs, chairSalesSeries{
+			ID:     chair.ID,
+			Name:   chair.Name,
+			Model:  chair.Model,
+			Series: builder.build(),
+		})
+	}
+	for _, chair := range chairs {
+		builder, ok := modelBuilders[chair.Model]
+		if !ok {
+			continue
+		}
+		res.Models = append(res.Models, modelSalesSeries{
+			Model:  chair.Model,
+			Series: builder.build(),
+		})
+		delete(modelBuilders, chair.Model)
+	}
+
+	writeJSON(w, http.StatusOK, res)
+}
+
+const (
+	salesExportFormatCSV    = "csv"
+	salesExportFormatNDJSON = "ndjson"
+	// salesExportFlushRows 書き出す途中でレスポンスをフラッシュする行数
+	salesExportFlushRows = 1000
+)
+
+// salesExportRow 書き出す売上の1行。完了したライドの運賃・キャンセル料・返金をそれぞれ1行にする。返金の売上は負の値になる
+type salesExportRow struct {
+	Kind       string        `db:"kind" json:"kind"`
+	RideID     string        `db:"ride_id" json:"ride_id"`
+	ChairID    string        `db:"chair_id" json:"chair_id"`
+	ChairName  string        `db:"chair_name" json:"chair_name"`
+	Model      string        `db:"model" json:"model"`
+	OccurredAt time.Time     `db:"occurred_at" json:"-"`
+	Sales      int           `db:"sales" json:"sales"`
+	Evaluation sql.NullInt64 `db:"evaluation" json:"-"`
+}
+
+var salesExportCSVHeader = []string{"kind", "ride_id", "chair_id", "chair_name", "model", "occurred_at", "sales", "evaluation"}
+
+func (row *salesExportRow) csvRecord() []string {
+	evaluation := ""
+	if row.Evaluation.Valid {
+		evaluation = strconv.FormatInt(row.Evaluation.Int64, 10)
+	}
+	return []string{
+		row.Kind,
+		row.RideID,
+		row.ChairID,
+		row.ChairName,
+		row.Model,
+		strconv.FormatInt(row.OccurredAt.UnixMilli(), 10),
+		strconv.Itoa(row.Sales),
+		evaluation,
+	}
+}
+
+func (row *salesExportRow) MarshalJSON() ([]byte, error) {
+	type alias salesExportRow
+	var evaluation *int64
+	if row.Evaluation.Valid {
+		evaluation = &row.Evaluation.Int64
+	}
+	return json.Marshal(&struct {
+		*alias
+		OccurredAt int64  `json:"occurred_at"`
+		Evaluation *int64 `json:"evaluation,omitempty"`
+	}{
+		alias:      (*alias)(row),
+		OccurredAt: row.OccurredAt.UnixMilli(),
+		Evaluation: evaluation,
+	})
+}
+
+// ownerGetSalesExport オーナーが管理している椅子の期間中の売上を、ライドごとにCSVもしくはNDJSONで書き出す
+// 行数が多くてもメモリに載せずに済むように、取得した行を順に書き出す
+func ownerGetSalesExport(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	owner := ctx.Value("owner").(*Owner)
+
+	since, until, err := parsePeriod(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	format := r.URL.Query().Get("format")
+	switch format {
+	case "":
+		format = salesExportFormatCSV
+	case salesExportFormatCSV, salesExportFormatNDJSON:
+	default:
+		writeError(w, http.StatusBadRequest, fmt.Errorf("unknown format: %s", format))
+		return
+	}
+
+	rows, err := db.QueryxContext(ctx, `SELECT 'RIDE' AS kind,
+       rides.id AS ride_id,
+       chairs.id AS chair_id,
+       chairs.name AS chair_name,
+       chairs.model AS model,
+       rides.updated_at AS occurred_at,
+       `+saleExpression+` AS sales,
+       rides.evaluation AS evaluation
+FROM rides
+       INNER JOIN chairs ON chairs.id = rides.chair_id
+       INNER JOIN ride_statuses ON ride_statuses.ride_id = rides.id
+WHERE chairs.owner_id = ?
+  AND ride_statuses.status = 'COMPLETED'
+  AND rides.updated_at BETWEEN ? AND ? + INTERVAL 999 MICROSECOND
+UNION ALL
+SELECT 'CANCELLATION' AS kind,
+       rides.id AS ride_id,
+       chairs.id AS chair_id,
+       chairs.name AS chair_name,
+       chairs.model AS model,
+       ride_cancellations.created_at AS occurred_at,
+       ride_cancellations.fee AS sales,
+       NULL AS evaluation
+FROM ride_cancellations
+       INNER JOIN rides ON rides.id = ride_cancellations.ride_id
+       INNER JOIN chairs ON chairs.id = rides.chair_id
+WHERE chairs.owner_id = ?
+  AND ride_cancellations.created_at BETWEEN ? AND ? + INTERVAL 999 MICROSECOND
+UNION ALL
+SELECT 'REFUND' AS kind,
+       rides.id AS ride_id,
+       chairs.id AS chair_id,
+       chairs.name AS chair_name,
+       chairs.model AS model,
+       payment_refunds.created_at AS occurred_at,
+       -payment_refunds.amount AS sales,
+       NULL AS evaluation
+FROM payment_refunds
+       INNER JOIN payments ON payments.id = payment_refunds.payment_id
+       INNER JOIN rides ON rides.id = payments.ride_id
+       INNER JOIN chairs ON chairs.id = rides.chair_id
+WHERE chairs.owner_id = ?
+  AND payment_refunds.status = 'SUCCEEDED'
+  AND payment_refunds.created_at BETWEEN ? AND ? + INTERVAL 999 MICROSECOND
+ORDER BY occurred_at, ride_id`, owner.ID, since, until, owner.ID, since, until, owner.ID, since, until)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	defer rows.Close()
+
+	var write func(row *salesExportRow) error
+	var flush func() error
+	switch format {
+	case salesExportFormatCSV:
+		w.Header().Set("Content-Type", "text/csv;charset=utf-8")
+		cw := csv.NewWriter(w)
+		if err := cw.Write(salesExportCSVHeader); err != nil {
+			return
+		}
+		write = func(row *salesExportRow) error { return cw.Write(row.csvRecord()) }
+		flush = func() error {
+			cw.Flush()
+			return cw.Error()
+		}
+	case salesExportFormatNDJSON:
+		w.Header().Set("Content-Type", "application/x-ndjson;charset=utf-8")
+		enc := json.NewEncoder(w)
+		write = func(row *salesExportRow) error { return enc.Encode(row) }
+		flush = func() error { return nil }
+	}
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="sales.%s"`, format))
+
+	// 書き出し始めた後はステータスコードを変えられないので、エラーが起きたら途中で打ち切る
+	count := 0
+	for rows.Next() {
+		row := &salesExportRow{}
+		if err := rows.StructScan(row); err != nil {
+			return
+		}
+		if err := write(row); err != nil {
+			return
+		}
+		count++
+		if count%salesExportFlushRows == 0 {
+			if err := flush(); err != nil {
+				return
+			}
+			if err := http.NewResponseController(w).Flush(); err != nil {
+				return
+			}
+		}
+	}
+	if rows.Err() != nil {
+		return
+	}
+	_ = flush()
+}
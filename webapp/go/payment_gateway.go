@@ -7,91 +7,109 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
-	"time"
 )
 
-var erroredUpstream = errors.New("errored upstream")
+var (
+	erroredUpstream = errors.New("errored upstream")
+	// errPaymentRejected 決済マイクロサービスに決済を拒否された。同じ内容でリトライしても成功しない
+	errPaymentRejected = errors.New("payment rejected")
+)
 
 type paymentGatewayPostPaymentRequest struct {
 	Amount int `json:"amount"`
 }
 
-type paymentGatewayGetPaymentsResponseOne struct {
-	Amount int    `json:"amount"`
-	Status string `json:"status"`
+type paymentGatewayErrorResponse struct {
+	Message string `json:"message"`
 }
 
-func requestPaymentGatewayPostPayment(ctx context.Context, paymentGatewayURL string, token string, param *paymentGatewayPostPaymentRequest, retrieveRidesOrderByCreatedAtAsc func() ([]Ride, error)) error {
+// requestPaymentGatewayPostPayment 決済マイクロサービスに決済を1回依頼する
+// 同じidempotencyKeyのリクエストは何度送っても一度しか決済されないので、失敗したら同じキーでリトライする
+// リトライしても成功しない場合はerrPaymentRejected、リトライすべき場合はerroredUpstreamを返す
+func requestPaymentGatewayPostPayment(ctx context.Context, paymentGatewayURL string, token string, idempotencyKey string, param *paymentGatewayPostPaymentRequest) error {
 	b, err := json.Marshal(param)
 	if err != nil {
 		return err
 	}
 
-	// 失敗したらとりあえずリトライ
-	// FIXME: 社内決済マイクロサービスのインフラに異常が発生していて、同時にたくさんリクエストすると変なことになる可能性あり
-	retry := 0
-	for {
-		err := func() error {
-			req, err := http.NewRequestWithContext(ctx, http.MethodPost, paymentGatewayURL+"/payments", bytes.NewBuffer(b))
-			if err != nil {
-				return err
-			}
-			req.Header.Set("Content-Type", "application/json")
-			req.Header.Set("Authorization", "Bearer "+token)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, paymentGatewayURL+"/payments", bytes.NewBuffer(b))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Idempotency-Key", idempotencyKey)
 
-			res, err := http.DefaultClient.Do(req)
-			if err != nil {
-				return err
-			}
-			defer res.Body.Close()
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		// 決済されたかどうか分からないが、同じキーでリトライすれば二重に決済されることはない
+		return fmt.Errorf("%w: %w", erroredUpstream, err)
+	}
+	defer res.Body.Close()
 
-			if res.StatusCode != http.StatusNoContent {
-				// エラーが返ってきても成功している場合があるので、社内決済マイクロサービスに問い合わせ
-				getReq, err := http.NewRequestWithContext(ctx, http.MethodGet, paymentGatewayURL+"/payments", bytes.NewBuffer([]byte{}))
-				if err != nil {
-					return err
-				}
-				getReq.Header.Set("Authorization", "Bearer "+token)
+	switch res.StatusCode {
+	case http.StatusNoContent:
+		return nil
+	case http.StatusConflict:
+		// 同じキーのリクエストがまだ処理中。処理が終わった後にリトライすれば結果が返る
+		return fmt.Errorf("payment is in progress: %w", erroredUpstream)
+	case http.StatusUnprocessableEntity:
+		// 同じキーで異なる内容の決済が記録されている
+		return fmt.Errorf("%w: payload differs from the recorded payment: %s", errPaymentRejected, readPaymentGatewayError(res))
+	case http.StatusBadRequest:
+		return fmt.Errorf("%w: %s", errPaymentRejected, readPaymentGatewayError(res))
+	default:
+		return fmt.Errorf("[POST /payments] unexpected status code (%d): %w", res.StatusCode, erroredUpstream)
+	}
+}
 
-				getRes, err := http.DefaultClient.Do(getReq)
-				if err != nil {
-					return err
-				}
-				defer res.Body.Close()
+type paymentGatewayPostRefundRequest struct {
+	// PaymentIdempotencyKey 返金する決済を送った時のIdempotency-Key
+	PaymentIdempotencyKey string `json:"payment_idempotency_key"`
+	Amount                int    `json:"amount"`
+}
 
-				// GET /payments は障害と関係なく200が返るので、200以外は回復不能なエラーとする
-				if getRes.StatusCode != http.StatusOK {
-					return fmt.Errorf("[GET /payments] unexpected status code (%d)", getRes.StatusCode)
-				}
-				var payments []paymentGatewayGetPaymentsResponseOne
-				if err := json.NewDecoder(getRes.Body).Decode(&payments); err != nil {
-					return err
-				}
+// requestPaymentGatewayPostRefund 決済マイクロサービスに返金を1回依頼する
+// 決済と同じく、同じidempotencyKeyのリクエストは何度送っても一度しか返金されないので、失敗したら同じキーでリトライする
+func requestPaymentGatewayPostRefund(ctx context.Context, paymentGatewayURL string, token string, idempotencyKey string, param *paymentGatewayPostRefundRequest) error {
+	b, err := json.Marshal(param)
+	if err != nil {
+		return err
+	}
 
-				rides, err := retrieveRidesOrderByCreatedAtAsc()
-				if err != nil {
-					return err
-				}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, paymentGatewayURL+"/refunds", bytes.NewBuffer(b))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Idempotency-Key", idempotencyKey)
 
-				if len(rides) != len(payments) {
-					return fmt.Errorf("unexpected number of payments: %d != %d. %w", len(rides), len(payments), erroredUpstream)
-				}
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("%w: %w", erroredUpstream, err)
+	}
+	defer res.Body.Close()
 
-				return nil
-			}
-			return nil
-		}()
-		if err != nil {
-			if retry < 5 {
-				retry++
-				time.Sleep(100 * time.Millisecond)
-				continue
-			} else {
-				return err
-			}
-		}
-		break
+	switch res.StatusCode {
+	case http.StatusNoContent:
+		return nil
+	case http.StatusConflict:
+		// 同じキーの返金か、返金する決済がまだ処理中
+		return fmt.Errorf("refund is in progress: %w", erroredUpstream)
+	case http.StatusUnprocessableEntity:
+		return fmt.Errorf("%w: payload differs from the recorded refund: %s", errPaymentRejected, readPaymentGatewayError(res))
+	case http.StatusBadRequest, http.StatusNotFound:
+		return fmt.Errorf("%w: %s", errPaymentRejected, readPaymentGatewayError(res))
+	default:
+		return fmt.Errorf("[POST /refunds] unexpected status code (%d): %w", res.StatusCode, erroredUpstream)
 	}
+}
 
-	return nil
+func readPaymentGatewayError(res *http.Response) string {
+	body := &paymentGatewayErrorResponse{}
+	if err := json.NewDecoder(res.Body).Decode(body); err != nil {
+		return fmt.Sprintf("status code %d", res.StatusCode)
+	}
+	return body.Message
 }
@@ -0,0 +1,299 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"net/http"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/oklog/ulid/v2"
+)
+
+// ユーザーは決済トークンを複数登録し、そのうち1つをデフォルトにできる
+// ライドには作成時に指定された決済トークン(指定が無ければデフォルトの決済トークン)を記録し、運賃やキャンセル料はその決済トークンで支払う
+
+var errPaymentTokenNotFound = errors.New("payment method not found")
+
+// lockUserPaymentTokens ユーザーの決済トークンの登録・削除・デフォルトの変更が同時に行われないように、ユーザーをロックする
+func lockUserPaymentTokens(ctx context.Context, tx *sqlx.Tx, userID string) error {
+	_, err := tx.ExecContext(ctx, `SELECT id FROM users WHERE id = ? FOR UPDATE`, userID)
+	return err
+}
+
+// getUserPaymentToken ユーザーが登録した決済トークンを取得する
+func getUserPaymentToken(ctx context.Context, tx *sqlx.Tx, userID, paymentTokenID string, forUpdate bool) (*PaymentToken, error) {
+	query := `SELECT * FROM payment_tokens WHERE id = ? AND user_id = ?`
+	if forUpdate {
+		query += ` FOR UPDATE`
+	}
+	paymentToken := &PaymentToken{}
+	if err := tx.GetContext(ctx, paymentToken, query, paymentTokenID, userID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errPaymentTokenNotFound
+		}
+		return nil, err
+	}
+	return paymentToken, nil
+}
+
+// getDefaultPaymentToken ユーザーのデフォルトの決済トークンを取得する。登録されていなければnilを返す
+func getDefaultPaymentToken(ctx context.Context, tx *sqlx.Tx, userID string) (*PaymentToken, error) {
+	paymentToken := &PaymentToken{}
+	if err := tx.GetContext(ctx, paymentToken, `SELECT * FROM payment_tokens WHERE user_id = ? AND is_default = TRUE`, userID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return paymentToken, nil
+}
+
+// resolveRidePaymentToken ライドの支払いに使う決済トークンを返す
+// ライドの作成時に決済トークンが登録されていなかった場合は、今のデフォルトの決済トークンをライドに記録する。それも無ければnilを返す
+func resolveRidePaymentToken(ctx context.Context, tx *sqlx.Tx, ride *Ride) (*PaymentToken, error) {
+	if ride.PaymentTokenID.Valid {
+		return getUserPaymentToken(ctx, tx, ride.UserID, ride.PaymentTokenID.String, false)
+	}
+
+	paymentToken, err := getDefaultPaymentToken(ctx, tx, ride.UserID)
+	if err != nil || paymentToken == nil {
+		return nil, err
+	}
+	// 更新日時はライドの完了日時として使われているので変えない
+	if _, err := tx.ExecContext(ctx, `UPDATE rides SET payment_token_id = ?, updated_at = updated_at WHERE id = ?`, paymentToken.ID, ride.ID); err != nil {
+		return nil, err
+	}
+	ride.PaymentTokenID = sql.NullString{String: paymentToken.ID, Valid: true}
+	return paymentToken, nil
+}
+
+type appPostPaymentMethodsRequest struct {
+	Token string `json:"token"`
+	// IsDefault デフォルトにするかどうか。最初に登録した決済トークンは指定が無くてもデフォルトになる
+	IsDefault bool `json:"is_default"`
+}
+
+func appPostPaymentMethods(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	req := &appPostPaymentMethodsRequest{}
+	if err := bindJSON(r, req); err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	if req.Token == "" {
+		writeError(w, http.StatusBadRequest, errors.New("token is required but was empty"))
+		return
+	}
+
+	user := ctx.Value("user").(*User)
+
+	tx, err := db.Beginx()
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	defer tx.Rollback()
+
+	if err := lockUserPaymentTokens(ctx, tx, user.ID); err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	defaultPaymentToken, err := getDefaultPaymentToken(ctx, tx, user.ID)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	isDefault := req.IsDefault || defaultPaymentToken == nil
+	if isDefault && defaultPaymentToken != nil {
+		if _, err := tx.ExecContext(ctx, `UPDATE payment_tokens SET is_default = FALSE WHERE id = ?`, defaultPaymentToken.ID); err != nil {
+			writeError(w, http.StatusInternalServerError, err)
+			return
+		}
+	}
+
+	paymentTokenID := ulid.Make().String()
+	if _, err := tx.ExecContext(
+		ctx,
+		`INSERT INTO payment_tokens (id, user_id, token, is_default) VALUES (?, ?, ?, ?)`,
+		paymentTokenID, user.ID, req.Token, isDefault,
+	); err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	// 決済トークンが登録されていない間に作成したライドは、最初に登録した決済トークンで支払う
+	if defaultPaymentToken == nil {
+		if _, err := tx.ExecContext(ctx, `UPDATE rides SET payment_token_id = ?, updated_at = updated_at WHERE user_id = ? AND payment_token_id IS NULL`, paymentTokenID, user.ID); err != nil {
+			writeError(w, http.StatusInternalServerError, err)
+			return
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	if defaultPaymentToken == nil {
+		// 決済トークンが無くて送れなかったキャンセル料を送る
+		wakePayment()
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+type appGetPaymentMethodsResponse struct {
+	PaymentMethods []appGetPaymentMethodsResponseItem `json:"payment_methods"`
+}
+
+type appGetPaymentMethodsResponseItem struct {
+	ID string `json:"id"`
+	// Last4 決済トークンの末尾4文字。決済トークンそのものは返さない
+	Last4     string `json:"last4"`
+	IsDefault bool   `json:"is_default"`
+	CreatedAt int64  `json:"created_at"`
+}
+
+// appGetPaymentMethods ユーザーが登録した決済トークンを登録順に取得する
+func appGetPaymentMethods(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	user := ctx.Value("user").(*User)
+
+	paymentTokens := []PaymentToken{}
+	if err := db.SelectContext(ctx, &paymentTokens, `SELECT * FROM payment_tokens WHERE user_id = ? ORDER BY created_at, id`, user.ID); err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	items := make([]appGetPaymentMethodsResponseItem, 0, len(paymentTokens))
+	for _, paymentToken := range paymentTokens {
+		items = append(items, appGetPaymentMethodsResponseItem{
+			ID:        paymentToken.ID,
+			Last4:     paymentToken.Token[max(0, len(paymentToken.Token)-4):],
+			IsDefault: paymentToken.IsDefault,
+			CreatedAt: paymentToken.CreatedAt.UnixMilli(),
+		})
+	}
+
+	writeJSON(w, http.StatusOK, &appGetPaymentMethodsResponse{
+		PaymentMethods: items,
+	})
+}
+
+// appPostPaymentMethodDefault ユーザーが決済トークンをデフォルトにする。以降に作成するライドで使われる
+func appPostPaymentMethodDefault(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	user := ctx.Value("user").(*User)
+	paymentTokenID := r.PathValue("payment_method_id")
+
+	tx, err := db.Beginx()
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	defer tx.Rollback()
+
+	if err := lockUserPaymentTokens(ctx, tx, user.ID); err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	paymentToken, err := getUserPaymentToken(ctx, tx, user.ID, paymentTokenID, false)
+	if err != nil {
+		writePaymentTokenError(w, err)
+		return
+	}
+
+	if _, err := tx.ExecContext(ctx, `UPDATE payment_tokens SET is_default = (id = ?) WHERE user_id = ?`, paymentToken.ID, user.ID); err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	if err := tx.Commit(); err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// appDeletePaymentMethod ユーザーが決済トークンを削除する
+// 終わっていないライドや、まだ決済もしくは返金が済んでいない支払いに使う決済トークンは削除できない
+// デフォルトの決済トークンを削除した場合は、残っているうち最後に登録したものをデフォルトにする
+func appDeletePaymentMethod(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	user := ctx.Value("user").(*User)
+	paymentTokenID := r.PathValue("payment_method_id")
+
+	tx, err := db.Beginx()
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	defer tx.Rollback()
+
+	if err := lockUserPaymentTokens(ctx, tx, user.ID); err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	// 削除するまでに決済トークンで返金が登録されないように、決済トークンをロックする
+	paymentToken, err := getUserPaymentToken(ctx, tx, user.ID, paymentTokenID, true)
+	if err != nil {
+		writePaymentTokenError(w, err)
+		return
+	}
+
+	// FAILEDの決済や返金も次の起動時にリトライされるので、済んでいないものとして扱う
+	var pendingCharges int
+	if err := tx.GetContext(ctx, &pendingCharges, `SELECT (SELECT COUNT(*)
+        FROM rides
+        WHERE rides.payment_token_id = ?
+          AND NOT EXISTS (SELECT 1 FROM ride_statuses WHERE ride_statuses.ride_id = rides.id AND ride_statuses.status IN ('COMPLETED', 'CANCELED'))) +
+       (SELECT COUNT(*)
+        FROM payments
+               INNER JOIN rides ON rides.id = payments.ride_id
+        WHERE rides.payment_token_id = ?
+          AND payments.status IN ('PENDING', 'FAILED')) +
+       (SELECT COUNT(*)
+        FROM payment_refunds
+               INNER JOIN payments ON payments.id = payment_refunds.payment_id
+               INNER JOIN rides ON rides.id = payments.ride_id
+        WHERE rides.payment_token_id = ?
+          AND payment_refunds.status IN ('PENDING', 'FAILED'))`, paymentToken.ID, paymentToken.ID, paymentToken.ID); err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	if pendingCharges > 0 {
+		writeError(w, http.StatusConflict, errors.New("payment method has pending charges"))
+		return
+	}
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM payment_tokens WHERE id = ?`, paymentToken.ID); err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	if paymentToken.IsDefault {
+		if _, err := tx.ExecContext(ctx, `UPDATE payment_tokens SET is_default = TRUE WHERE user_id = ? ORDER BY created_at DESC, id DESC LIMIT 1`, user.ID); err != nil {
+			writeError(w, http.StatusInternalServerError, err)
+			return
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// writePaymentTokenError 決済トークンの取得に失敗した時のレスポンスを返す
+func writePaymentTokenError(w http.ResponseWriter, err error) {
+	if errors.Is(err, errPaymentTokenNotFound) {
+		writeError(w, http.StatusNotFound, err)
+		return
+	}
+	writeError(w, http.StatusInternalServerError, err)
+}
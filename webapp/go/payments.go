@@ -0,0 +1,208 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+// 評価の際にpaymentsテーブルへPENDINGの決済を記録し、決済マイクロサービスに送る
+// 既定では評価のリクエストの中で成功するまで送り、ISUCON_ASYNC_PAYMENTを指定した場合はバックグラウンドで送る
+// チップは運賃とは別の決済(kindがTIP)として記録する
+// 送信に失敗した決済は指数バックオフでリトライし、リトライし尽くしたものはFAILEDにする
+// FAILEDの決済はオーナーから確認でき、次にプロセスが起動した時に再びリトライする
+// オーナーが登録した返金(payment_refunds)も同じように送る
+
+const (
+	// paymentPollInterval 送信待ちの決済を確認する間隔
+	paymentPollInterval = 100 * time.Millisecond
+	// paymentMaxAttempts リクエストがこの回数失敗したら諦めてFAILEDにする
+	paymentMaxAttempts = 10
+	// paymentRetryBaseDelay 最初のリトライまでの待ち時間。以降は失敗する度に倍にする
+	paymentRetryBaseDelay = 100 * time.Millisecond
+	// paymentRetryMaxDelay リトライまでの最大の待ち時間
+	paymentRetryMaxDelay = 10 * time.Second
+	// paymentBatchSize 一度に送信する決済の最大数
+	paymentBatchSize = 100
+)
+
+var paymentWakeCh = make(chan struct{}, 1)
+
+// paymentAsync 評価の決済を、レスポンスを返した後にバックグラウンドで送るかどうか
+var paymentAsync bool
+
+// wakePayment 次の間隔を待たずに送信待ちの決済を送らせる
+func wakePayment() {
+	select {
+	case paymentWakeCh <- struct{}{}:
+	default:
+		// 既に起こされているので何もしない
+	}
+}
+
+// runPaymentLoop ctxがキャンセルされるまで、送信待ちの決済を決済マイクロサービスに送り続ける
+func runPaymentLoop(ctx context.Context) {
+	// 前回の起動中にリトライし尽くした決済も、もう一度リトライする
+	if err := requeueFailedPayments(ctx); err != nil {
+		slog.Error("failed to requeue failed payments", slog.Any("error", err))
+	}
+
+	ticker := time.NewTicker(paymentPollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-paymentWakeCh:
+		}
+
+		if err := deliverPayments(ctx); err != nil && ctx.Err() == nil {
+			slog.Error("failed to deliver payments", slog.Any("error", err))
+		}
+		if err := deliverRefunds(ctx); err != nil && ctx.Err() == nil {
+			slog.Error("failed to deliver refunds", slog.Any("error", err))
+		}
+	}
+}
+
+func requeueFailedPayments(ctx context.Context) error {
+	if _, err := db.ExecContext(ctx, `UPDATE payments SET status = 'PENDING', attempts = 0, next_attempt_at = CURRENT_TIMESTAMP(6) WHERE status = 'FAILED'`); err != nil {
+		return err
+	}
+	_, err := db.ExecContext(ctx, `UPDATE payment_refunds SET status = 'PENDING', attempts = 0, next_attempt_at = CURRENT_TIMESTAMP(6) WHERE status = 'FAILED'`)
+	return err
+}
+
+func getPaymentGatewayURL(ctx context.Context) (string, error) {
+	var paymentGatewayURL string
+	err := db.GetContext(ctx, &paymentGatewayURL, "SELECT value FROM settings WHERE name = 'payment_gateway_url'")
+	return paymentGatewayURL, err
+}
+
+type pendingPayment struct {
+	Payment
+	Token string `db:"token"`
+}
+
+// deliverPayments リトライの時間が来た送信待ちの決済を並行して送り、結果を記録する
+// 決済はライドに記録された決済トークンで行う
+func deliverPayments(ctx context.Context) error {
+	payments := []pendingPayment{}
+	if err := db.SelectContext(ctx, &payments, `SELECT payments.*, payment_tokens.token
+FROM payments
+       INNER JOIN rides ON rides.id = payments.ride_id
+       INNER JOIN payment_tokens ON payment_tokens.id = rides.payment_token_id
+WHERE payments.status = 'PENDING'
+  AND payments.next_attempt_at <= CURRENT_TIMESTAMP(6)
+ORDER BY payments.next_attempt_at
+LIMIT ?`, paymentBatchSize); err != nil {
+		return err
+	}
+	if len(payments) == 0 {
+		return nil
+	}
+
+	paymentGatewayURL, err := getPaymentGatewayURL(ctx)
+	if err != nil {
+		return err
+	}
+
+	var wg sync.WaitGroup
+	for _, payment := range payments {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			err := requestPaymentGatewayPostPayment(ctx, paymentGatewayURL, payment.Token, payment.IdempotencyKey, &paymentGatewayPostPaymentRequest{
+				Amount: payment.Amount,
+			})
+			if ctx.Err() != nil {
+				// シャットダウン中なので記録せず、次の起動時に送り直す
+				return
+			}
+			if err := recordGatewayAttempt(ctx, "payments", payment.ID, payment.Attempts, err); err != nil {
+				slog.Error("failed to record payment attempt", slog.String("payment_id", payment.ID), slog.Any("error", err))
+			}
+		}()
+	}
+	wg.Wait()
+
+	return nil
+}
+
+// deliverRidePayments ライドの送信待ちの決済を、成功するかリトライし尽くすまでその場で送る
+// 送れなかった決済はPENDINGもしくはFAILEDのまま残り、バックグラウンドでリトライされる
+func deliverRidePayments(ctx context.Context, rideID string) error {
+	payments := []pendingPayment{}
+	if err := db.SelectContext(ctx, &payments, `SELECT payments.*, payment_tokens.token
+FROM payments
+       INNER JOIN rides ON rides.id = payments.ride_id
+       INNER JOIN payment_tokens ON payment_tokens.id = rides.payment_token_id
+WHERE payments.ride_id = ?
+  AND payments.status = 'PENDING'
+ORDER BY payments.created_at`, rideID); err != nil {
+		return err
+	}
+	if len(payments) == 0 {
+		return nil
+	}
+
+	paymentGatewayURL, err := getPaymentGatewayURL(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, payment := range payments {
+		for attempts := payment.Attempts; ; attempts++ {
+			err := requestPaymentGatewayPostPayment(ctx, paymentGatewayURL, payment.Token, payment.IdempotencyKey, &paymentGatewayPostPaymentRequest{
+				Amount: payment.Amount,
+			})
+			if recordErr := recordGatewayAttempt(ctx, "payments", payment.ID, attempts, err); recordErr != nil {
+				return recordErr
+			}
+			if err == nil {
+				break
+			}
+			if errors.Is(err, errPaymentRejected) || attempts+1 >= paymentMaxAttempts {
+				return err
+			}
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(paymentRetryDelay(attempts + 1)):
+			}
+		}
+	}
+	return nil
+}
+
+// recordGatewayAttempt 決済マイクロサービスへのリクエストの結果を、決済(payments)もしくは返金(payment_refunds)に記録する
+func recordGatewayAttempt(ctx context.Context, table string, id string, attempts int, err error) error {
+	var recordErr error
+	switch {
+	case err == nil:
+		_, recordErr = db.ExecContext(ctx, `UPDATE `+table+` SET status = 'SUCCEEDED', attempts = attempts + 1, last_error = NULL WHERE id = ?`, id)
+	case errors.Is(err, errPaymentRejected):
+		_, recordErr = db.ExecContext(ctx, `UPDATE `+table+` SET status = 'REJECTED', attempts = attempts + 1, last_error = ? WHERE id = ?`, err.Error(), id)
+	case attempts+1 >= paymentMaxAttempts:
+		_, recordErr = db.ExecContext(ctx, `UPDATE `+table+` SET status = 'FAILED', attempts = attempts + 1, last_error = ? WHERE id = ?`, err.Error(), id)
+	default:
+		_, recordErr = db.ExecContext(
+			ctx,
+			`UPDATE `+table+` SET attempts = attempts + 1, last_error = ?, next_attempt_at = DATE_ADD(CURRENT_TIMESTAMP(6), INTERVAL ? MICROSECOND) WHERE id = ?`,
+			err.Error(), paymentRetryDelay(attempts+1).Microseconds(), id,
+		)
+	}
+	return recordErr
+}
+
+// paymentRetryDelay attempts回失敗した後、次にリトライするまでの待ち時間
+func paymentRetryDelay(attempts int) time.Duration {
+	delay := paymentRetryBaseDelay
+	for i := 1; i < attempts && delay < paymentRetryMaxDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, paymentRetryMaxDelay)
+}
@@ -0,0 +1,265 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// 相乗りを希望したライドは、空いている椅子に加えて、相乗りのライドを運んでいる椅子にも割り当てる
+// 既に乗っている(乗る予定の)ユーザーと新しく乗るユーザーのどちらも、遠回りがpoolDetourBudget以内で済む場合だけ相乗りさせる
+// 距離に応じた運賃は、相乗りを希望したライドが実際に他のライドと同じ椅子に乗り合わせた時に割り引く
+
+const (
+	// poolDiscountPercentage 相乗りになったライドの距離に応じた運賃の割引率(%)
+	poolDiscountPercentage = 20
+	// maxPoolRiders 相乗りで1台の椅子に乗れるユーザーの数
+	maxPoolRiders = 2
+	// poolDetourBudget 相乗りによってそれぞれのユーザーが遠回りしてよいマンハッタン距離
+	poolDetourBudget = 20
+)
+
+// errPooledRideWithStops 経由地のあるライドは相乗りにできない
+var errPooledRideWithStops = errors.New("pooled ride cannot have stops")
+
+// applyPoolDiscount 相乗りになったライドの距離に応じた運賃を割り引く
+func applyPoolDiscount(meteredFare int, shared bool) int {
+	if !shared {
+		return meteredFare
+	}
+	return meteredFare * (100 - poolDiscountPercentage) / 100
+}
+
+// discountSharedRides 相乗りになったライドの運賃を、相乗りの割引をしたものにする
+// 運賃は割引前の運賃から求め直すので、相乗りの相手が入れ替わっても割引は重ならない
+func discountSharedRides(ctx context.Context, rideIDs []string) error {
+	tx, err := db.Beginx()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	// 評価と同じくライドをロックしてから運賃を読み書きする。デッドロックしないようにID順にロックする
+	slices.Sort(rideIDs)
+	for _, rideID := range rideIDs {
+		ride := &Ride{}
+		if err := tx.GetContext(ctx, ride, `SELECT * FROM rides WHERE id = ? FOR UPDATE`, rideID); err != nil {
+			return err
+		}
+		// 評価やキャンセルで支払う額が決まったライドの運賃は変えない
+		status, err := getLatestRideStatus(ctx, tx, ride.ID)
+		if err != nil {
+			return err
+		}
+		if status == "COMPLETED" || status == "CANCELED" {
+			continue
+		}
+		coupon, err := findRideCoupon(ctx, tx, ride.ID)
+		if err != nil {
+			return err
+		}
+		stops, err := getRideStops(ctx, tx, ride.ID)
+		if err != nil {
+			return err
+		}
+		fare := calculateFareBreakdown(calculateRideRouteDistance(ride, stops), ride.SurgeRate, true, coupon)
+		if _, err := tx.ExecContext(
+			ctx,
+			`UPDATE rides SET metered_fare = ?, discount = ?, fare = ? WHERE id = ?`,
+			fare.MeteredFare, fare.Discount, fare.Fare, ride.ID,
+		); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+type poolingRide struct {
+	ID                   string `db:"id"`
+	ChairID              string `db:"chair_id"`
+	IsPooled             bool   `db:"is_pooled"`
+	PickupLatitude       int    `db:"pickup_latitude"`
+	PickupLongitude      int    `db:"pickup_longitude"`
+	DestinationLatitude  int    `db:"destination_latitude"`
+	DestinationLongitude int    `db:"destination_longitude"`
+	// PickedUp ユーザーが既に乗車しているかどうか
+	PickedUp bool `db:"picked_up"`
+}
+
+type poolableChair struct {
+	ID        string `db:"id"`
+	Latitude  int    `db:"latitude"`
+	Longitude int    `db:"longitude"`
+	rides     []poolingRide
+}
+
+// getPoolableChairs 相乗りのライドだけを運んでいて、まだ乗れる余裕のある椅子を取得する
+func getPoolableChairs(ctx context.Context) ([]poolableChair, error) {
+	rides := []poolingRide{}
+	// 椅子が空いているかどうかはgetFreeChairsと同じく、完了もしくはキャンセルの通知が椅子に届いたかで判断する
+	if err := db.SelectContext(ctx, &rides, `SELECT rides.id,
+       rides.chair_id,
+       rides.is_pooled,
+       rides.pickup_latitude,
+       rides.pickup_longitude,
+       rides.destination_latitude,
+       rides.destination_longitude,
+       EXISTS (SELECT 1 FROM ride_statuses WHERE ride_statuses.ride_id = rides.id AND ride_statuses.status = 'CARRYING') AS picked_up
+FROM rides
+       INNER JOIN chairs ON chairs.id = rides.chair_id
+WHERE chairs.is_active = TRUE
+  AND chairs.retired_at IS NULL
+  AND NOT EXISTS (SELECT 1
+                  FROM ride_statuses
+                  WHERE ride_statuses.ride_id = rides.id
+                    AND ride_statuses.status IN ('COMPLETED', 'CANCELED')
+                    AND ride_statuses.chair_sent_at IS NOT NULL)
+ORDER BY rides.created_at`); err != nil {
+		return nil, err
+	}
+
+	ridesByChair := map[string][]poolingRide{}
+	for _, ride := range rides {
+		ridesByChair[ride.ChairID] = append(ridesByChair[ride.ChairID], ride)
+	}
+	chairIDs := []string{}
+	for chairID, rides := range ridesByChair {
+		if len(rides) >= maxPoolRiders || slices.ContainsFunc(rides, func(r poolingRide) bool { return !r.IsPooled }) {
+			delete(ridesByChair, chairID)
+			continue
+		}
+		chairIDs = append(chairIDs, chairID)
+	}
+	if len(chairIDs) == 0 {
+		return nil, nil
+	}
+
+	// 最新の位置は、getFreeChairsと同じくchair_distancesからまとめて読む
+	query, args, err := sqlx.In(`SELECT chair_id AS id, latitude, longitude FROM chair_distances WHERE chair_id IN (?) ORDER BY chair_id`, chairIDs)
+	if err != nil {
+		return nil, err
+	}
+	chairs := []poolableChair{}
+	if err := db.SelectContext(ctx, &chairs, db.Rebind(query), args...); err != nil {
+		return nil, err
+	}
+	for i := range chairs {
+		chairs[i].rides = ridesByChair[chairs[i].ID]
+	}
+	return chairs, nil
+}
+
+// canPool 椅子が運んでいるライドと新しいライドを相乗りにしても、どちらのユーザーの遠回りも予算内に収まるかどうか
+// 先に乗っているユーザーを先に降ろす順番と、新しく乗るユーザーを先に降ろす順番のどちらかで収まればよい
+func (c *poolableChair) canPool(ride *waitingRide) bool {
+	for _, current := range c.rides {
+		// 乗車前なら配車位置から、乗車後なら椅子の現在位置から目的地までの経路に割り込む
+		originLatitude, originLongitude := current.PickupLatitude, current.PickupLongitude
+		if current.PickedUp {
+			originLatitude, originLongitude = c.Latitude, c.Longitude
+		}
+		direct := calculateDistance(originLatitude, originLongitude, current.DestinationLatitude, current.DestinationLongitude)
+		toPickup := calculateDistance(originLatitude, originLongitude, ride.PickupLatitude, ride.PickupLongitude)
+		rideDirect := calculateDistance(ride.PickupLatitude, ride.PickupLongitude, ride.DestinationLatitude, ride.DestinationLongitude)
+		pickupToCurrentDestination := calculateDistance(ride.PickupLatitude, ride.PickupLongitude, current.DestinationLatitude, current.DestinationLongitude)
+		betweenDestinations := calculateDistance(current.DestinationLatitude, current.DestinationLongitude, ride.DestinationLatitude, ride.DestinationLongitude)
+
+		// 先に乗っているユーザーを先に降ろす
+		currentFirst := toPickup+pickupToCurrentDestination-direct <= poolDetourBudget &&
+			pickupToCurrentDestination+betweenDestinations-rideDirect <= poolDetourBudget
+		// 新しく乗るユーザーを先に降ろす
+		rideFirst := toPickup+rideDirect+betweenDestinations-direct <= poolDetourBudget
+		if !currentFirst && !rideFirst {
+			return false
+		}
+	}
+	return true
+}
+
+// matchPooledRides 椅子が割り当てられなかった相乗りを希望するライドを、相乗りできる椅子に割り当てる
+// 待たせている順に、相乗りできる椅子のうち配車位置に最も近い椅子を割り当てる
+func matchPooledRides(ctx context.Context) (int, error) {
+	rides, err := getWaitingRides(ctx)
+	if err != nil {
+		return 0, err
+	}
+	rides = slices.DeleteFunc(rides, func(r waitingRide) bool { return !r.IsPooled })
+	if len(rides) == 0 {
+		return 0, nil
+	}
+	chairs, err := getPoolableChairs(ctx)
+	if err != nil || len(chairs) == 0 {
+		return 0, err
+	}
+
+	matched := 0
+	for _, ride := range rides {
+		nearest := -1
+		nearestDistance := 0
+		for i := range chairs {
+			if ride.rejectedBy[chairs[i].ID] || !chairs[i].canPool(&ride) {
+				continue
+			}
+			distance := calculateDistance(chairs[i].Latitude, chairs[i].Longitude, ride.PickupLatitude, ride.PickupLongitude)
+			if nearest < 0 || distance < nearestDistance {
+				nearest, nearestDistance = i, distance
+			}
+		}
+		if nearest < 0 {
+			continue
+		}
+
+		ok, err := assignRide(ctx, ride.ID, chairs[nearest].ID)
+		if err != nil {
+			return matched, err
+		}
+		if ok {
+			matched++
+			chair := &chairs[nearest]
+			// 椅子が運んでいるライドと新しいライドのどちらも相乗りになる
+			sharedRideIDs := []string{ride.ID}
+			for _, current := range chair.rides {
+				sharedRideIDs = append(sharedRideIDs, current.ID)
+			}
+			if err := discountSharedRides(ctx, sharedRideIDs); err != nil {
+				return matched, err
+			}
+			chair.rides = append(chair.rides, poolingRide{
+				ID:                   ride.ID,
+				ChairID:              chair.ID,
+				IsPooled:             true,
+				PickupLatitude:       ride.PickupLatitude,
+				PickupLongitude:      ride.PickupLongitude,
+				DestinationLatitude:  ride.DestinationLatitude,
+				DestinationLongitude: ride.DestinationLongitude,
+			})
+			if len(chair.rides) >= maxPoolRiders {
+				chairs = slices.Delete(chairs, nearest, nearest+1)
+				if len(chairs) == 0 {
+					break
+				}
+			}
+		}
+	}
+	return matched, nil
+}
+
+// countCoRiders 同じ椅子に相乗りしている(これから乗る)他のユーザーの数
+func countCoRiders(ctx context.Context, tx *sqlx.Tx, ride *Ride) (int, error) {
+	if !ride.ChairID.Valid {
+		return 0, nil
+	}
+	var count int
+	if err := tx.GetContext(ctx, &count, `SELECT COUNT(*)
+FROM rides
+WHERE chair_id = ?
+  AND id != ?
+  AND NOT EXISTS (SELECT 1 FROM ride_statuses WHERE ride_statuses.ride_id = rides.id AND ride_statuses.status IN ('ARRIVED', 'COMPLETED', 'CANCELED'))`,
+		ride.ChairID, ride.ID,
+	); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
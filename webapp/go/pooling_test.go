@@ -0,0 +1,89 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApplyPoolDiscount(t *testing.T) {
+	tests := []struct {
+		name        string
+		meteredFare int
+		shared      bool
+		expected    int
+	}{
+		{name: "相乗りにならなければ割り引かない", meteredFare: 1000, shared: false, expected: 1000},
+		{name: "相乗りになれば割り引く", meteredFare: 1000, shared: true, expected: 800},
+		{name: "割り引いた端数は切り捨てる", meteredFare: 999, shared: true, expected: 799},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, applyPoolDiscount(tt.meteredFare, tt.shared))
+		})
+	}
+}
+
+func TestCalculateFareBreakdown_Shared(t *testing.T) {
+	discountPercentage := 10
+	coupon := &usableCoupon{DiscountPercentage: &discountPercentage}
+
+	notShared := calculateFareBreakdown(10, minSurgeRate, false, coupon)
+	shared := calculateFareBreakdown(10, minSurgeRate, true, coupon)
+
+	assert.Equal(t, farePerDistance*10, notShared.MeteredFare)
+	assert.Equal(t, farePerDistance*10*(100-poolDiscountPercentage)/100, shared.MeteredFare)
+	// 割引率のクーポンは相乗りの割引後の運賃に掛ける
+	assert.Equal(t, shared.MeteredFare*discountPercentage/100, shared.Discount)
+	assert.Equal(t, initialFare+shared.MeteredFare-shared.Discount, shared.Fare)
+}
+
+func TestPoolableChair_canPool(t *testing.T) {
+	current := poolingRide{
+		ID:                   "current",
+		IsPooled:             true,
+		PickupLatitude:       0,
+		PickupLongitude:      0,
+		DestinationLatitude:  50,
+		DestinationLongitude: 0,
+	}
+	pickedUp := current
+	pickedUp.PickedUp = true
+
+	tests := []struct {
+		name     string
+		chair    poolableChair
+		ride     waitingRide
+		expected bool
+	}{
+		{
+			name:     "同じ方向に向かうライドは相乗りできる",
+			chair:    poolableChair{Latitude: 0, Longitude: 0, rides: []poolingRide{current}},
+			ride:     waitingRide{PickupLatitude: 10, PickupLongitude: 0, DestinationLatitude: 40, DestinationLongitude: 0},
+			expected: true,
+		},
+		{
+			name:     "逆の方向に向かうライドは相乗りできない",
+			chair:    poolableChair{Latitude: 0, Longitude: 0, rides: []poolingRide{current}},
+			ride:     waitingRide{PickupLatitude: 10, PickupLongitude: 0, DestinationLatitude: -40, DestinationLongitude: 0},
+			expected: false,
+		},
+		{
+			name:     "乗車前なら配車位置からの経路に割り込める",
+			chair:    poolableChair{Latitude: 45, Longitude: 0, rides: []poolingRide{current}},
+			ride:     waitingRide{PickupLatitude: 0, PickupLongitude: 0, DestinationLatitude: 50, DestinationLongitude: 0},
+			expected: true,
+		},
+		{
+			name:     "乗車後は椅子の現在位置から戻る遠回りになるので相乗りできない",
+			chair:    poolableChair{Latitude: 45, Longitude: 0, rides: []poolingRide{pickedUp}},
+			ride:     waitingRide{PickupLatitude: 0, PickupLongitude: 0, DestinationLatitude: 50, DestinationLongitude: 0},
+			expected: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.chair.canPool(&tt.ride))
+		})
+	}
+}
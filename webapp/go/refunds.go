@@ -0,0 +1,275 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+	"net/http"
+	"sync"
+
+	"github.com/oklog/ulid/v2"
+)
+
+// オーナーは、椅子が運んだライドの運賃もしくはキャンセル料の決済の全額もしくは一部を返金できる
+// 返金は決済と同じくPENDINGで記録し、バックグラウンドで決済マイクロサービスに送る
+// 返金が済んだら、返金を登録した日時の椅子の売上から差し引く
+
+var errRideNotFound = errors.New("ride not found")
+
+type ownerPostRideRefundRequest struct {
+	// Amount 返金額。指定しなければ、まだ返金していない残りを全て返金する
+	Amount *int    `json:"amount"`
+	Reason *string `json:"reason"`
+}
+
+type ownerRideRefund struct {
+	ID        string  `json:"id"`
+	Amount    int     `json:"amount"`
+	Reason    *string `json:"reason,omitempty"`
+	Status    string  `json:"status"`
+	Attempts  int     `json:"attempts"`
+	LastError *string `json:"last_error,omitempty"`
+	CreatedAt int64   `json:"created_at"`
+	UpdatedAt int64   `json:"updated_at"`
+}
+
+func newOwnerRideRefund(refund *PaymentRefund) ownerRideRefund {
+	return ownerRideRefund{
+		ID:        refund.ID,
+		Amount:    refund.Amount,
+		Reason:    refund.Reason,
+		Status:    refund.Status,
+		Attempts:  refund.Attempts,
+		LastError: refund.LastError,
+		CreatedAt: refund.CreatedAt.UnixMilli(),
+		UpdatedAt: refund.UpdatedAt.UnixMilli(),
+	}
+}
+
+// getOwnerRidePayment オーナーが管理している椅子が運んだライドの決済を取得する。決済されていなければnilを返す
+func getOwnerRidePayment(ctx context.Context, tx executableGet, ownerID, rideID string, forUpdate bool) (*Payment, error) {
+	var found bool
+	if err := tx.GetContext(ctx, &found, `SELECT EXISTS(SELECT 1 FROM rides INNER JOIN chairs ON chairs.id = rides.chair_id WHERE rides.id = ? AND chairs.owner_id = ?)`, rideID, ownerID); err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, errRideNotFound
+	}
+
+	// チップは返金できない
+	query := `SELECT * FROM payments WHERE ride_id = ? AND kind = 'RIDE'`
+	if forUpdate {
+		query += ` FOR UPDATE`
+	}
+	payment := &Payment{}
+	if err := tx.GetContext(ctx, payment, query, rideID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return payment, nil
+}
+
+// getRefundedAmount 決済のうち返金した額の合計。拒否された返金は含まない
+func getRefundedAmount(ctx context.Context, tx executableGet, paymentID string) (int, error) {
+	var refunded int
+	err := tx.GetContext(ctx, &refunded, `SELECT IFNULL(SUM(amount), 0) FROM payment_refunds WHERE payment_id = ? AND status != 'REJECTED'`, paymentID)
+	return refunded, err
+}
+
+// ownerPostRideRefund オーナーがライドの決済を返金する
+// 決済が成功していなければ返金できず、返金額の合計は決済額を超えられない
+func ownerPostRideRefund(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	owner := ctx.Value("owner").(*Owner)
+	rideID := r.PathValue("ride_id")
+
+	req := &ownerPostRideRefundRequest{}
+	if err := bindJSON(r, req); err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	if req.Amount != nil && *req.Amount <= 0 {
+		writeError(w, http.StatusBadRequest, errors.New("amount must be positive"))
+		return
+	}
+
+	tx, err := db.Beginx()
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	defer tx.Rollback()
+
+	// 同じ決済の返金が同時に登録されて決済額を超えないように、決済をロックする
+	payment, err := getOwnerRidePayment(ctx, tx, owner.ID, rideID, true)
+	if err != nil {
+		if errors.Is(err, errRideNotFound) {
+			writeError(w, http.StatusNotFound, err)
+			return
+		}
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	if payment == nil || payment.Status != "SUCCEEDED" {
+		writeError(w, http.StatusBadRequest, errors.New("ride payment has not succeeded"))
+		return
+	}
+
+	refunded, err := getRefundedAmount(ctx, tx, payment.ID)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	remaining := payment.Amount - refunded
+	if remaining <= 0 {
+		writeError(w, http.StatusConflict, errors.New("ride payment is already fully refunded"))
+		return
+	}
+	amount := remaining
+	if req.Amount != nil {
+		if *req.Amount > remaining {
+			writeError(w, http.StatusBadRequest, errors.New("amount exceeds the refundable amount"))
+			return
+		}
+		amount = *req.Amount
+	}
+
+	// 決済に使った決済トークンが削除されていると返金できない
+	// 返金を登録するまでに決済トークンが削除されないように、決済トークンをロックする
+	var paymentTokenID string
+	if err := tx.GetContext(ctx, &paymentTokenID, `SELECT payment_tokens.id FROM rides INNER JOIN payment_tokens ON payment_tokens.id = rides.payment_token_id WHERE rides.id = ? FOR UPDATE OF payment_tokens`, rideID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			writeError(w, http.StatusConflict, errors.New("payment method of the ride was deleted"))
+			return
+		}
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	// 返金IDをIdempotency-Keyにして、リトライしても二重に返金されないようにする
+	refundID := ulid.Make().String()
+	if _, err := tx.ExecContext(
+		ctx,
+		`INSERT INTO payment_refunds (id, payment_id, idempotency_key, amount, reason, status) VALUES (?, ?, ?, ?, ?, 'PENDING')`,
+		refundID, payment.ID, refundID, amount, req.Reason,
+	); err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	refund := &PaymentRefund{}
+	if err := tx.GetContext(ctx, refund, `SELECT * FROM payment_refunds WHERE id = ?`, refundID); err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	if err := tx.Commit(); err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	wakePayment()
+
+	writeJSON(w, http.StatusAccepted, newOwnerRideRefund(refund))
+}
+
+type ownerGetRideRefundsResponse struct {
+	// PaymentAmount 決済額。決済されていなければ0
+	PaymentAmount int `json:"payment_amount"`
+	// RefundedAmount 返金した額の合計。拒否された返金は含まない
+	RefundedAmount int               `json:"refunded_amount"`
+	Refunds        []ownerRideRefund `json:"refunds"`
+}
+
+// ownerGetRideRefunds オーナーがライドの返金を登録順に取得する
+func ownerGetRideRefunds(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	owner := ctx.Value("owner").(*Owner)
+	rideID := r.PathValue("ride_id")
+
+	payment, err := getOwnerRidePayment(ctx, db, owner.ID, rideID, false)
+	if err != nil {
+		if errors.Is(err, errRideNotFound) {
+			writeError(w, http.StatusNotFound, err)
+			return
+		}
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	res := ownerGetRideRefundsResponse{Refunds: []ownerRideRefund{}}
+	if payment == nil {
+		writeJSON(w, http.StatusOK, res)
+		return
+	}
+	res.PaymentAmount = payment.Amount
+
+	refunds := []PaymentRefund{}
+	if err := db.SelectContext(ctx, &refunds, `SELECT * FROM payment_refunds WHERE payment_id = ? ORDER BY created_at, id`, payment.ID); err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	for _, refund := range refunds {
+		if refund.Status != "REJECTED" {
+			res.RefundedAmount += refund.Amount
+		}
+		res.Refunds = append(res.Refunds, newOwnerRideRefund(&refund))
+	}
+
+	writeJSON(w, http.StatusOK, res)
+}
+
+type pendingRefund struct {
+	PaymentRefund
+	PaymentIdempotencyKey string `db:"payment_idempotency_key"`
+	Token                 string `db:"token"`
+}
+
+// deliverRefunds リトライの時間が来た送信待ちの返金を並行して送り、結果を記録する
+// 返金は決済と同じく、ライドに記録された決済トークンで行う
+func deliverRefunds(ctx context.Context) error {
+	refunds := []pendingRefund{}
+	if err := db.SelectContext(ctx, &refunds, `SELECT payment_refunds.*, payments.idempotency_key AS payment_idempotency_key, payment_tokens.token
+FROM payment_refunds
+       INNER JOIN payments ON payments.id = payment_refunds.payment_id
+       INNER JOIN rides ON rides.id = payments.ride_id
+       INNER JOIN payment_tokens ON payment_tokens.id = rides.payment_token_id
+WHERE payment_refunds.status = 'PENDING'
+  AND payment_refunds.next_attempt_at <= CURRENT_TIMESTAMP(6)
+ORDER BY payment_refunds.next_attempt_at
+LIMIT ?`, paymentBatchSize); err != nil {
+		return err
+	}
+	if len(refunds) == 0 {
+		return nil
+	}
+
+	paymentGatewayURL, err := getPaymentGatewayURL(ctx)
+	if err != nil {
+		return err
+	}
+
+	var wg sync.WaitGroup
+	for _, refund := range refunds {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			err := requestPaymentGatewayPostRefund(ctx, paymentGatewayURL, refund.Token, refund.IdempotencyKey, &paymentGatewayPostRefundRequest{
+				PaymentIdempotencyKey: refund.PaymentIdempotencyKey,
+				Amount:                refund.Amount,
+			})
+			if ctx.Err() != nil {
+				// シャットダウン中なので記録せず、次の起動時に送り直す
+				return
+			}
+			if err := recordGatewayAttempt(ctx, "payment_refunds", refund.ID, refund.Attempts, err); err != nil {
+				slog.Error("failed to record refund attempt", slog.String("refund_id", refund.ID), slog.Any("error", err))
+			}
+		}()
+	}
+	wg.Wait()
+
+	return nil
+}
@@ -0,0 +1,119 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/oklog/ulid/v2"
+)
+
+// ライドの状態の遷移はここで定義したものしか許さない。ride_statusesに書き込む処理は必ずtransitionRideを通す
+// 遷移ごとに、遷移させられる主体(ユーザー・椅子・システム)も決めておく
+
+type rideActor string
+
+const (
+	// rideActorRider ライドを依頼したユーザー
+	rideActorRider rideActor = "rider"
+	// rideActorChair ライドに割り当てられた椅子
+	rideActorChair rideActor = "chair"
+	// rideActorSystem 椅子の位置やマッチングからアプリケーションが判断するもの
+	rideActorSystem rideActor = "system"
+)
+
+var errIllegalRideTransition = errors.New("illegal ride status transition")
+
+// rideTransitions 遷移元の状態 → 遷移先の状態 → 遷移させられる主体。遷移元の""はライドの作成を表す
+var rideTransitions = map[string]map[string][]rideActor{
+	"": {
+		"SCHEDULED": {rideActorRider},
+		"MATCHING":  {rideActorRider},
+	},
+	"SCHEDULED": {
+		// 予約の変更で配車日時が近づいた時と、マッチングを始める時間になった時
+		"MATCHING": {rideActorRider, rideActorSystem},
+		"CANCELED": {rideActorRider},
+	},
+	"MATCHING": {
+		"ENROUTE": {rideActorChair},
+		// 割り当てられた椅子が断った時
+		"MATCHING": {rideActorChair},
+		"CANCELED": {rideActorRider},
+	},
+	"ENROUTE": {
+		"PICKUP": {rideActorSystem},
+		// 引き受けた椅子が辞退した時
+		"MATCHING": {rideActorChair},
+		"CANCELED": {rideActorRider},
+	},
+	"PICKUP": {
+		"CARRYING": {rideActorChair},
+		"MATCHING": {rideActorChair},
+		"CANCELED": {rideActorRider},
+	},
+	"CARRYING": {
+		"WAYPOINT": {rideActorSystem},
+		"ARRIVED":  {rideActorSystem},
+	},
+	"WAYPOINT": {
+		"WAYPOINT": {rideActorSystem},
+		"ARRIVED":  {rideActorSystem},
+	},
+	"ARRIVED": {
+		// ユーザーが評価した時
+		"COMPLETED": {rideActorRider},
+	},
+}
+
+// canTransitionRide 主体がライドの状態をfromからtoに遷移させられるか
+func canTransitionRide(from, to string, actor rideActor) bool {
+	for _, a := range rideTransitions[from][to] {
+		if a == actor {
+			return true
+		}
+	}
+	return false
+}
+
+// checkRideTransition 遷移できなければerrIllegalRideTransitionを包んだエラーを返す
+func checkRideTransition(from, to string, actor rideActor) error {
+	if !canTransitionRide(from, to, actor) {
+		if from == "" {
+			return fmt.Errorf("%w: %s cannot create ride as %s", errIllegalRideTransition, actor, to)
+		}
+		return fmt.Errorf("%w: %s cannot change ride from %s to %s", errIllegalRideTransition, actor, from, to)
+	}
+	return nil
+}
+
+// transitionRide ライドの状態をtoに遷移させ、遷移前の状態を返す
+// 同じライドの遷移が同時に行われないように、ライドをロックしてから最新の状態を確認する
+func transitionRide(ctx context.Context, tx *sqlx.Tx, rideID string, actor rideActor, to string) (string, error) {
+	if _, err := tx.ExecContext(ctx, `SELECT id FROM rides WHERE id = ? FOR UPDATE`, rideID); err != nil {
+		return "", err
+	}
+	from, err := getLatestRideStatus(ctx, tx, rideID)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return "", err
+	}
+	if err := checkRideTransition(from, to, actor); err != nil {
+		return from, err
+	}
+	if _, err := tx.ExecContext(ctx, `INSERT INTO ride_statuses (id, ride_id, status) VALUES (?, ?, ?)`, ulid.Make().String(), rideID, to); err != nil {
+		return from, err
+	}
+	return from, nil
+}
+
+// writeRideTransitionError ライドの状態の遷移に失敗した時のレスポンスを返す
+func writeRideTransitionError(w http.ResponseWriter, err error) {
+	if errors.Is(err, errIllegalRideTransition) {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	writeError(w, http.StatusInternalServerError, err)
+}
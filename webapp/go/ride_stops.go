@@ -0,0 +1,155 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// ライドには配車位置と目的地の間に経由地を順番に指定できる
+// 運賃は配車位置から経由地を順に回って目的地に着くまでの距離で求める
+// 椅子が経由地に着くとride_stopsに到着日時を記録し、WAYPOINTの状態を通知して次の目的地を知らせる
+
+// maxRideStops 1つのライドに指定できる経由地の数
+const maxRideStops = 3
+
+// validateRideStops 経由地の指定が正しいか検証する
+// 椅子は位置を送る度に1つずつ到着を判定するので、直前の位置や目的地と同じ位置の経由地は指定できない
+func validateRideStops(pickup Coordinate, stops []Coordinate, destination Coordinate) error {
+	if len(stops) > maxRideStops {
+		return fmt.Errorf("too many stops (max: %d)", maxRideStops)
+	}
+	previous := pickup
+	for _, stop := range stops {
+		if stop == previous {
+			return errors.New("stop must differ from the previous point")
+		}
+		previous = stop
+	}
+	if len(stops) > 0 && previous == destination {
+		return errors.New("last stop must differ from the destination")
+	}
+	return nil
+}
+
+// calculateRouteDistance 配車位置から経由地を順に回って目的地に着くまでのマンハッタン距離
+func calculateRouteDistance(pickup Coordinate, stops []Coordinate, destination Coordinate) int {
+	distance := 0
+	current := pickup
+	for _, stop := range stops {
+		distance += calculateDistance(current.Latitude, current.Longitude, stop.Latitude, stop.Longitude)
+		current = stop
+	}
+	return distance + calculateDistance(current.Latitude, current.Longitude, destination.Latitude, destination.Longitude)
+}
+
+// calculateRideRouteDistance ライドの配車位置から経由地を回って目的地に着くまでのマンハッタン距離
+func calculateRideRouteDistance(ride *Ride, stops []RideStop) int {
+	return calculateRouteDistance(
+		Coordinate{Latitude: ride.PickupLatitude, Longitude: ride.PickupLongitude},
+		rideStopCoordinates(stops),
+		Coordinate{Latitude: ride.DestinationLatitude, Longitude: ride.DestinationLongitude},
+	)
+}
+
+// rideStopCoordinates 経由地の位置を経由する順に返す
+func rideStopCoordinates(stops []RideStop) []Coordinate {
+	coordinates := make([]Coordinate, 0, len(stops))
+	for _, stop := range stops {
+		coordinates = append(coordinates, Coordinate{Latitude: stop.Latitude, Longitude: stop.Longitude})
+	}
+	return coordinates
+}
+
+// insertRideStops ライドの経由地を指定された順番で登録する
+func insertRideStops(ctx context.Context, tx *sqlx.Tx, rideID string, stops []Coordinate) error {
+	for i, stop := range stops {
+		if _, err := tx.ExecContext(
+			ctx,
+			`INSERT INTO ride_stops (ride_id, stop_order, latitude, longitude) VALUES (?, ?, ?, ?)`,
+			rideID, i+1, stop.Latitude, stop.Longitude,
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getRideStops ライドの経由地を経由する順に取得する
+func getRideStops(ctx context.Context, tx *sqlx.Tx, rideID string) ([]RideStop, error) {
+	stops := []RideStop{}
+	if err := tx.SelectContext(ctx, &stops, `SELECT * FROM ride_stops WHERE ride_id = ? ORDER BY stop_order`, rideID); err != nil {
+		return nil, err
+	}
+	return stops, nil
+}
+
+// nextRideStop まだ到着していない最初の経由地を返す。全ての経由地に到着していればnilを返す
+func nextRideStop(stops []RideStop) *RideStop {
+	for i := range stops {
+		if stops[i].ArrivedAt == nil {
+			return &stops[i]
+		}
+	}
+	return nil
+}
+
+// arriveAtRideStop 経由地への到着を記録し、WAYPOINTの状態を通知する
+func arriveAtRideStop(ctx context.Context, tx *sqlx.Tx, stop *RideStop) error {
+	if _, err := tx.ExecContext(
+		ctx,
+		`UPDATE ride_stops SET arrived_at = CURRENT_TIMESTAMP(6) WHERE ride_id = ? AND stop_order = ?`,
+		stop.RideID, stop.StopOrder,
+	); err != nil {
+		return err
+	}
+	_, err := transitionRide(ctx, tx, stop.RideID, rideActorSystem, "WAYPOINT")
+	return err
+}
+
+type rideStopResponse struct {
+	Coordinate Coordinate `json:"coordinate"`
+	// ArrivedAt 経由地に到着した日時 (UNIXミリ秒)。まだ到着していなければ含まれない
+	ArrivedAt *int64 `json:"arrived_at,omitempty"`
+}
+
+// rideStopsResponse 経由地をレスポンスの形式にする。経由地が無ければnilを返す
+func rideStopsResponse(stops []RideStop) []rideStopResponse {
+	if len(stops) == 0 {
+		return nil
+	}
+	res := make([]rideStopResponse, 0, len(stops))
+	for _, stop := range stops {
+		item := rideStopResponse{
+			Coordinate: Coordinate{Latitude: stop.Latitude, Longitude: stop.Longitude},
+		}
+		if stop.ArrivedAt != nil {
+			t := stop.ArrivedAt.UnixMilli()
+			item.ArrivedAt = &t
+		}
+		res = append(res, item)
+	}
+	return res
+}
+
+// nextRideTarget 椅子が次に向かう位置。配車位置に着くまでは配車位置、乗車後は次の経由地、全ての経由地に着いた後は目的地になる
+func nextRideTarget(ride *Ride, status string, stops []RideStop) Coordinate {
+	switch status {
+	case "CARRYING", "WAYPOINT":
+		if stop := nextRideStop(stops); stop != nil {
+			return Coordinate{Latitude: stop.Latitude, Longitude: stop.Longitude}
+		}
+		return Coordinate{Latitude: ride.DestinationLatitude, Longitude: ride.DestinationLongitude}
+	case "ARRIVED", "COMPLETED":
+		return Coordinate{Latitude: ride.DestinationLatitude, Longitude: ride.DestinationLongitude}
+	default:
+		return Coordinate{Latitude: ride.PickupLatitude, Longitude: ride.PickupLongitude}
+	}
+}
+
+// isCarryingRide ユーザーが乗車して目的地もしくは経由地に向かっている状態かどうか
+func isCarryingRide(status string) bool {
+	return status == "CARRYING" || status == "WAYPOINT"
+}
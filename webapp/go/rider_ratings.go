@@ -0,0 +1,158 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"net/http"
+	"slices"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// 椅子も、完了したライドのユーザーを1〜5で評価できる
+// ユーザーの評価は椅子への通知に含め、設定すればマッチングで評価の低いユーザーのライドを後回しにする
+
+// lowRatedRiderThreshold 評価の平均がこれ未満のユーザーのライドは、マッチングで後回しにする。0以下なら後回しにしない
+var lowRatedRiderThreshold float64
+
+// lowRatedRiderMinEvaluations 後回しにするかを判断するのに必要な評価の数。数回の低い評価だけでは後回しにしない
+const lowRatedRiderMinEvaluations = 3
+
+type riderStats struct {
+	// TotalRidesCount 完了したライドの数
+	TotalRidesCount int `json:"total_rides_count" db:"total_rides_count"`
+	// TotalEvaluationAvg 椅子による評価の平均。評価されていなければ0
+	TotalEvaluationAvg float64 `json:"total_evaluation_avg" db:"total_evaluation_avg"`
+}
+
+// getRiderStats ユーザーのライドの数と、椅子による評価の平均を取得する
+// ユーザーが評価するとライドが完了するので、ユーザーの評価があるライドを完了したライドとして数える
+func getRiderStats(ctx context.Context, tx executableGet, userID string) (*riderStats, error) {
+	stats := &riderStats{}
+	if err := tx.GetContext(ctx, stats, `SELECT COUNT(evaluation) AS total_rides_count, IFNULL(AVG(rider_evaluation), 0) AS total_evaluation_avg FROM rides WHERE user_id = ?`, userID); err != nil {
+		return nil, err
+	}
+	return stats, nil
+}
+
+// markLowRatedRiders 待機中のライドのうち評価の低いユーザーのものに印を付け、待たせている順を保ったまま後ろに回す
+func markLowRatedRiders(ctx context.Context, rides []waitingRide) error {
+	if lowRatedRiderThreshold <= 0 || len(rides) == 0 {
+		return nil
+	}
+
+	userIDs := make([]string, 0, len(rides))
+	for _, ride := range rides {
+		userIDs = append(userIDs, ride.UserID)
+	}
+	query, args, err := sqlx.In(`SELECT user_id
+FROM rides
+WHERE user_id IN (?)
+  AND rider_evaluation IS NOT NULL
+GROUP BY user_id
+HAVING COUNT(*) >= ? AND AVG(rider_evaluation) < ?`, userIDs, lowRatedRiderMinEvaluations, lowRatedRiderThreshold)
+	if err != nil {
+		return err
+	}
+	lowRatedUserIDs := []string{}
+	if err := db.SelectContext(ctx, &lowRatedUserIDs, db.Rebind(query), args...); err != nil {
+		return err
+	}
+	if len(lowRatedUserIDs) == 0 {
+		return nil
+	}
+
+	lowRated := map[string]bool{}
+	for _, userID := range lowRatedUserIDs {
+		lowRated[userID] = true
+	}
+	for i := range rides {
+		rides[i].lowRated = lowRated[rides[i].UserID]
+	}
+	slices.SortStableFunc(rides, func(a, b waitingRide) int {
+		return compareLowRated(&a, &b)
+	})
+	return nil
+}
+
+// compareLowRated 評価の低いユーザーのライドを後にする比較関数
+func compareLowRated(a, b *waitingRide) int {
+	switch {
+	case a.lowRated == b.lowRated:
+		return 0
+	case a.lowRated:
+		return 1
+	default:
+		return -1
+	}
+}
+
+type chairPostRideEvaluationRequest struct {
+	Evaluation int `json:"evaluation"`
+}
+
+// chairPostRideEvaluation 椅子が完了したライドのユーザーを評価する。評価できるのは1回だけ
+func chairPostRideEvaluation(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	chair := ctx.Value("chair").(*Chair)
+	rideID := r.PathValue("ride_id")
+
+	req := &chairPostRideEvaluationRequest{}
+	if err := bindJSON(r, req); err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	if req.Evaluation < 1 || req.Evaluation > 5 {
+		writeError(w, http.StatusBadRequest, errors.New("evaluation must be between 1 and 5"))
+		return
+	}
+
+	tx, err := db.Beginx()
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	defer tx.Rollback()
+
+	ride := &Ride{}
+	if err := tx.GetContext(ctx, ride, `SELECT * FROM rides WHERE id = ? FOR UPDATE`, rideID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			writeError(w, http.StatusNotFound, errors.New("ride not found"))
+			return
+		}
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	if ride.ChairID.String != chair.ID {
+		writeError(w, http.StatusBadRequest, errors.New("not assigned to this ride"))
+		return
+	}
+
+	status, err := getLatestRideStatus(ctx, tx, ride.ID)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	if status != "COMPLETED" {
+		writeError(w, http.StatusBadRequest, errors.New("ride is not completed yet"))
+		return
+	}
+	if ride.RiderEvaluation != nil {
+		writeError(w, http.StatusConflict, errors.New("rider is already evaluated"))
+		return
+	}
+
+	// 更新日時はライドの完了日時として使われているので変えない
+	if _, err := tx.ExecContext(ctx, `UPDATE rides SET rider_evaluation = ?, updated_at = updated_at WHERE id = ?`, req.Evaluation, ride.ID); err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	if err := tx.Commit(); err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
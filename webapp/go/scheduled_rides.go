@@ -0,0 +1,73 @@
+package main
+
+import (
+	"context"
+	"time"
+)
+
+// 配車日時を予約したライドはSCHEDULEDで作成し、配車日時のscheduledRideLeadTime前になったらMATCHINGにしてマッチングの対象にする
+// MATCHINGになるまでは、ユーザーが予約を変更・キャンセルできる
+
+// scheduledRideLeadTime 予約したライドのマッチングを配車日時のどれだけ前から始めるか
+var scheduledRideLeadTime time.Duration
+
+// isScheduledForLater 配車日時がまだマッチングを始める時間になっていないかどうか
+func isScheduledForLater(scheduledAt *time.Time, now time.Time) bool {
+	return scheduledAt != nil && scheduledAt.After(now.Add(scheduledRideLeadTime))
+}
+
+// promoteScheduledRides マッチングを始める時間になった予約のライドをMATCHINGにする
+func promoteScheduledRides(ctx context.Context) error {
+	rideIDs := []string{}
+	if err := db.SelectContext(ctx, &rideIDs, `SELECT id
+FROM rides
+WHERE scheduled_at <= ?
+  AND chair_id IS NULL
+  AND NOT EXISTS (SELECT 1 FROM ride_statuses WHERE ride_statuses.ride_id = rides.id AND ride_statuses.status IN ('MATCHING', 'CANCELED'))`,
+		time.Now().Add(scheduledRideLeadTime),
+	); err != nil {
+		return err
+	}
+
+	for _, rideID := range rideIDs {
+		if err := promoteScheduledRide(ctx, rideID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func promoteScheduledRide(ctx context.Context, rideID string) error {
+	tx, err := db.Beginx()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	// 予約の変更・キャンセルと同時に行われないようにロックしてから状態を確認する
+	ride := &Ride{}
+	if err := tx.GetContext(ctx, ride, "SELECT * FROM rides WHERE id = ? FOR UPDATE", rideID); err != nil {
+		return err
+	}
+	status, err := getLatestRideStatus(ctx, tx, ride.ID)
+	if err != nil {
+		return err
+	}
+	if status != "SCHEDULED" || isScheduledForLater(ride.ScheduledAt, time.Now()) {
+		return nil
+	}
+
+	if _, err := transitionRide(ctx, tx, ride.ID, rideActorSystem, "MATCHING"); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// scheduledAtMilli 予約された配車日時をUNIXミリ秒で返す。予約でなければnilを返す
+func scheduledAtMilli(ride *Ride) *int64 {
+	if ride.ScheduledAt == nil {
+		return nil
+	}
+	t := ride.ScheduledAt.UnixMilli()
+	return &t
+}
@@ -0,0 +1,224 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// 通知のストリームはそれぞれにDBを確認せず、共有のポーラーが未通知の状態を見つけた時だけ確認する
+// ポーラーは一定間隔で未通知の状態を持つユーザーと椅子を探し、そのストリームを起こす
+
+// notificationPollInterval 共有のポーラーが未通知の状態を探す間隔
+const notificationPollInterval = 100 * time.Millisecond
+
+var (
+	// notificationStreamsDone サーバーのシャットダウン時に閉じられ、開いている通知のストリームを終了させる
+	notificationStreamsDone = make(chan struct{})
+	// closeNotificationStreams 開いている通知のストリームを全て終了させる
+	closeNotificationStreams = sync.OnceFunc(func() { close(notificationStreamsDone) })
+
+	// appNotificationStreams ユーザーIDごとの開いている通知のストリーム
+	appNotificationStreams = newNotificationHub()
+	// chairNotificationStreams 椅子IDごとの開いている通知のストリーム
+	chairNotificationStreams = newNotificationHub()
+)
+
+// notificationHub IDごとに開いている通知のストリームを起こす
+type notificationHub struct {
+	mu      sync.Mutex
+	streams map[string]map[chan struct{}]bool
+}
+
+func newNotificationHub() *notificationHub {
+	return &notificationHub{streams: map[string]map[chan struct{}]bool{}}
+}
+
+// subscribe IDのストリームとして登録し、起こされると受信できるチャンネルを返す
+// 確認している間に起こされても取りこぼさないように、チャンネルは1つだけバッファする
+func (h *notificationHub) subscribe(id string) (<-chan struct{}, func()) {
+	ch := make(chan struct{}, 1)
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.streams[id] == nil {
+		h.streams[id] = map[chan struct{}]bool{}
+	}
+	h.streams[id][ch] = true
+	return ch, func() {
+		h.mu.Lock()
+		defer h.mu.Unlock()
+		delete(h.streams[id], ch)
+		if len(h.streams[id]) == 0 {
+			delete(h.streams, id)
+		}
+	}
+}
+
+// wake IDのストリームを全て起こす
+func (h *notificationHub) wake(id string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for ch := range h.streams[id] {
+		select {
+		case ch <- struct{}{}:
+		default:
+			// 既に起こされているので何もしない
+		}
+	}
+}
+
+func (h *notificationHub) empty() bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return len(h.streams) == 0
+}
+
+// runNotificationPoller ctxがキャンセルされるまで、未通知の状態を持つユーザーと椅子のストリームを起こし続ける
+func runNotificationPoller(ctx context.Context) {
+	ticker := time.NewTicker(notificationPollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		if err := wakeNotificationStreams(ctx); err != nil && ctx.Err() == nil {
+			slog.Error("failed to poll notifications", slog.Any("error", err))
+		}
+	}
+}
+
+// wakeNotificationStreams 未通知の状態を持つユーザーと椅子のストリームを起こす
+func wakeNotificationStreams(ctx context.Context) error {
+	if !appNotificationStreams.empty() {
+		userIDs := []string{}
+		if err := db.SelectContext(ctx, &userIDs, `SELECT DISTINCT rides.user_id
+FROM ride_statuses
+       INNER JOIN rides ON rides.id = ride_statuses.ride_id
+WHERE ride_statuses.app_sent_at IS NULL`); err != nil {
+			return err
+		}
+		for _, userID := range userIDs {
+			appNotificationStreams.wake(userID)
+		}
+	}
+	if !chairNotificationStreams.empty() {
+		chairIDs := []string{}
+		if err := db.SelectContext(ctx, &chairIDs, `SELECT DISTINCT rides.chair_id
+FROM ride_statuses
+       INNER JOIN rides ON rides.id = ride_statuses.ride_id
+WHERE ride_statuses.chair_sent_at IS NULL
+  AND rides.chair_id IS NOT NULL`); err != nil {
+			return err
+		}
+		for _, chairID := range chairIDs {
+			chairNotificationStreams.wake(chairID)
+		}
+	}
+	return nil
+}
+
+// withEventStream Accept: text/event-stream のリクエストはstreamで、それ以外はpollingで処理する
+func withEventStream(stream, polling http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if strings.Contains(r.Header.Get("Accept"), "text/event-stream") {
+			stream(w, r)
+			return
+		}
+		polling(w, r)
+	}
+}
+
+func writeSSE(w http.ResponseWriter, data interface{}) error {
+	buf, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write([]byte("data: " + string(buf) + "\n\n")); err != nil {
+		return err
+	}
+	return http.NewResponseController(w).Flush()
+}
+
+// streamNotification 接続が切れるまで、next で取得した通知をServer-Sent Eventsで送り続ける
+// 最初は現在の状態を送り、以降はwokenで起こされて未通知の状態があった時だけ送る
+// 未通知の状態は、書き込んでフラッシュできてから markSent で通知済みにする。送れずに接続が切れた状態は再接続後に送り直す
+func streamNotification[T any](w http.ResponseWriter, r *http.Request, woken <-chan struct{}, next func(ctx context.Context) (*T, *RideStatus, error), markSent func(ctx context.Context, rideStatus *RideStatus) error) {
+	ctx := r.Context()
+
+	data, unsent, err := next(ctx)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+	if err := sendNotification(ctx, w, data, unsent, markSent); err != nil {
+		return
+	}
+
+	for {
+		data, unsent, err := next(ctx)
+		if err != nil {
+			if ctx.Err() == nil {
+				slog.Error("failed to get notification", slog.Any("error", err))
+			}
+			return
+		}
+		if unsent != nil {
+			// 続けて未通知の状態があるかもしれないので待たずに確認する
+			if err := sendNotification(ctx, w, data, unsent, markSent); err != nil {
+				return
+			}
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-notificationStreamsDone:
+			return
+		case <-woken:
+		}
+	}
+}
+
+// sendNotification 通知を送り、未通知の状態を送った場合は通知済みにする
+func sendNotification[T any](ctx context.Context, w http.ResponseWriter, data *T, unsent *RideStatus, markSent func(ctx context.Context, rideStatus *RideStatus) error) error {
+	if err := writeSSE(w, data); err != nil {
+		return err
+	}
+	if err := markSent(ctx, unsent); err != nil {
+		if ctx.Err() == nil {
+			slog.Error("failed to mark notification as sent", slog.Any("error", err))
+		}
+		return err
+	}
+	return nil
+}
+
+func appGetNotificationSSE(w http.ResponseWriter, r *http.Request) {
+	user := r.Context().Value("user").(*User)
+	woken, unsubscribe := appNotificationStreams.subscribe(user.ID)
+	defer unsubscribe()
+	streamNotification(w, r, woken, func(ctx context.Context) (*appGetNotificationResponseData, *RideStatus, error) {
+		return getAppNotification(ctx, user)
+	}, markAppNotificationSent)
+}
+
+func chairGetNotificationSSE(w http.ResponseWriter, r *http.Request) {
+	chair := r.Context().Value("chair").(*Chair)
+	woken, unsubscribe := chairNotificationStreams.subscribe(chair.ID)
+	defer unsubscribe()
+	streamNotification(w, r, woken, func(ctx context.Context) (*chairGetNotificationResponseData, *RideStatus, error) {
+		return getChairNotification(ctx, chair)
+	}, markChairNotificationSent)
+}
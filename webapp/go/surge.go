@@ -0,0 +1,51 @@
+package main
+
+import (
+	"context"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// 配車位置の周辺で椅子を待っているライドが空いている椅子より多い時、距離に応じた運賃を割り増す
+// 倍率はライドの作成時に確定させてridesテーブルに保存し、運賃・売上・決済額の計算では常にその倍率を使う
+
+const (
+	// surgeRegionDistance 需要と供給を数える、配車位置からのマンハッタン距離
+	surgeRegionDistance = 50
+	// minSurgeRate 割り増ししない時の倍率(%)
+	minSurgeRate = 100
+	// maxSurgeRate 倍率の上限(%)
+	maxSurgeRate = 200
+)
+
+// surgePricing 需要と供給に応じて運賃を割り増すかどうか。無効な時は常にminSurgeRateを使う
+var surgePricing bool
+
+// calculateSurgeRate 配車位置の周辺の待機中のライド数と空いている椅子の数の比から倍率(%)を求める
+func calculateSurgeRate(ctx context.Context, tx *sqlx.Tx, pickupLatitude, pickupLongitude int) (int, error) {
+	if !surgePricing {
+		return minSurgeRate, nil
+	}
+
+	var waitingRides int
+	if err := tx.GetContext(ctx, &waitingRides, `SELECT COUNT(*)
+FROM rides
+WHERE chair_id IS NULL
+  AND ABS(pickup_latitude - ?) + ABS(pickup_longitude - ?) <= ?
+  AND EXISTS (SELECT 1 FROM ride_statuses WHERE ride_statuses.ride_id = rides.id AND ride_statuses.status = 'MATCHING')
+  AND NOT EXISTS (SELECT 1 FROM ride_statuses WHERE ride_statuses.ride_id = rides.id AND ride_statuses.status = 'CANCELED')`,
+		pickupLatitude, pickupLongitude, surgeRegionDistance,
+	); err != nil {
+		return 0, err
+	}
+	if waitingRides == 0 {
+		return minSurgeRate, nil
+	}
+
+	// 空いている椅子は、マッチングのように最新の位置をDBから集計せず、メモリ上の索引から数える
+	freeChairs := len(availableChairs.search(Coordinate{Latitude: pickupLatitude, Longitude: pickupLongitude}, surgeRegionDistance))
+
+	// 空いている椅子が無い時は1台あるものとして扱う
+	rate := minSurgeRate * waitingRides / max(freeChairs, 1)
+	return min(max(rate, minSurgeRate), maxSurgeRate), nil
+}
@@ -10,14 +10,31 @@ import (
 )
 
 var (
-	data     = map[string][]int{}
+	data = map[string][]int{}
+	// payments Idempotency-Keyごとの決済。返金する決済を探すのに使う
+	payments = map[string]*payment{}
+	// refunds Idempotency-Keyごとの返金。同じキーの返金は一度しか行わない
+	refunds  = map[string]*refund{}
 	dataLock sync.Mutex
 )
 
+type payment struct {
+	token          string
+	amount         int
+	refundedAmount int
+}
+
+type refund struct {
+	token                 string
+	paymentIdempotencyKey string
+	amount                int
+}
+
 func main() {
 	mux := http.NewServeMux()
 	mux.HandleFunc("GET /payments", handleGetPayments)
 	mux.HandleFunc("POST /payments", handlePostPayments)
+	mux.HandleFunc("POST /refunds", handlePostRefunds)
 	http.ListenAndServe(":12345", mux)
 }
 
@@ -51,12 +68,71 @@ func handlePostPayments(w http.ResponseWriter, r *http.Request) {
 		data[token] = arr
 	}
 	arr = append(arr, req.Amount)
+	if idk := r.Header.Get("Idempotency-Key"); idk != "" {
+		if _, ok := payments[idk]; !ok {
+			payments[idk] = &payment{token: token, amount: req.Amount}
+		}
+	}
 	dataLock.Unlock()
 
 	slog.Info("決済完了", slog.String("token", token), slog.Int("amount", req.Amount))
 	w.WriteHeader(http.StatusNoContent)
 }
 
+type PostRefundsRequest struct {
+	PaymentIdempotencyKey string `json:"payment_idempotency_key"`
+	Amount                int    `json:"amount"`
+}
+
+func handlePostRefunds(w http.ResponseWriter, r *http.Request) {
+	idk := r.Header.Get("Idempotency-Key")
+	if idk == "" {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"message": "Idempotency-Key headerがセットされていません"})
+		return
+	}
+
+	token, err := getTokenFromAuthorizationHeader(r)
+	if err != nil {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"message": err.Error()})
+		return
+	}
+
+	var req PostRefundsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"message": "不正なリクエスト形式です"})
+		return
+	}
+
+	dataLock.Lock()
+	defer dataLock.Unlock()
+
+	// 同じキーの返金は、内容が同じなら返金済みとして扱う
+	if rf, ok := refunds[idk]; ok {
+		if rf.token != token || rf.paymentIdempotencyKey != req.PaymentIdempotencyKey || rf.amount != req.Amount {
+			writeJSON(w, http.StatusUnprocessableEntity, map[string]string{"message": "リクエストペイロードがサーバーに記録されているものと異なります"})
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	p, ok := payments[req.PaymentIdempotencyKey]
+	if !ok || p.token != token {
+		writeJSON(w, http.StatusNotFound, map[string]string{"message": "返金する決済が見つかりません"})
+		return
+	}
+	if req.Amount <= 0 || p.refundedAmount+req.Amount > p.amount {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"message": "返金額が不正です"})
+		return
+	}
+
+	p.refundedAmount += req.Amount
+	refunds[idk] = &refund{token: token, paymentIdempotencyKey: req.PaymentIdempotencyKey, amount: req.Amount}
+
+	slog.Info("返金完了", slog.String("token", token), slog.Int("amount", req.Amount))
+	w.WriteHeader(http.StatusNoContent)
+}
+
 type ResponsePayment struct {
 	Amount int    `json:"amount"`
 	Status string `json:"status"`
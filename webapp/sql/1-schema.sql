@@ -44,10 +44,33 @@ CREATE TABLE chair_locations
   latitude   INTEGER     NOT NULL COMMENT '経度',
   longitude  INTEGER     NOT NULL COMMENT '緯度',
   created_at DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6) COMMENT '登録日時',
-  PRIMARY KEY (id)
+  PRIMARY KEY (id),
+  INDEX idx_chair_id_created_at (chair_id, created_at)
 )
   COMMENT = '椅子の現在位置情報テーブル';
 
+DROP TABLE IF EXISTS chair_distances;
+CREATE TABLE chair_distances
+(
+  chair_id       VARCHAR(26) NOT NULL COMMENT '椅子ID',
+  total_distance INTEGER     NOT NULL COMMENT '総移動距離',
+  latitude       INTEGER     NOT NULL COMMENT '最後に記録した位置の経度',
+  longitude      INTEGER     NOT NULL COMMENT '最後に記録した位置の緯度',
+  updated_at     DATETIME(6) NOT NULL COMMENT '最後に記録した位置の登録日時',
+  PRIMARY KEY (chair_id)
+)
+  COMMENT = '椅子の総移動距離テーブル';
+
+DROP TABLE IF EXISTS chair_location_compactions;
+CREATE TABLE chair_location_compactions
+(
+  chair_id         VARCHAR(26) NOT NULL COMMENT '椅子ID',
+  removed_distance INTEGER     NOT NULL COMMENT '位置情報を間引いたことで位置情報から求まらなくなった移動距離',
+  updated_at       DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6) ON UPDATE CURRENT_TIMESTAMP(6) COMMENT '更新日時',
+  PRIMARY KEY (chair_id)
+)
+  COMMENT = '椅子の位置情報の間引きテーブル';
+
 DROP TABLE IF EXISTS users;
 CREATE TABLE users
 (
@@ -97,16 +120,49 @@ CREATE TABLE rides
 DROP TABLE IF EXISTS ride_statuses;
 CREATE TABLE ride_statuses
 (
-  id              VARCHAR(26)                                                                NOT NULL,
-  ride_id VARCHAR(26)                                                                        NOT NULL COMMENT 'ライドID',
-  status          ENUM ('MATCHING', 'ENROUTE', 'PICKUP', 'CARRYING', 'ARRIVED', 'COMPLETED') NOT NULL COMMENT '状態',
-  created_at      DATETIME(6)                                                                NOT NULL DEFAULT CURRENT_TIMESTAMP(6) COMMENT '状態変更日時',
-  app_sent_at     DATETIME(6)                                                                NULL COMMENT 'ユーザーへの状態通知日時',
-  chair_sent_at   DATETIME(6)                                                                NULL COMMENT '椅子への状態通知日時',
+  id              VARCHAR(26)                                                                            NOT NULL,
+  ride_id VARCHAR(26)                                                                                    NOT NULL COMMENT 'ライドID',
+  status          ENUM ('SCHEDULED', 'MATCHING', 'ENROUTE', 'PICKUP', 'CARRYING', 'WAYPOINT', 'ARRIVED', 'COMPLETED', 'CANCELED') NOT NULL COMMENT '状態',
+  created_at      DATETIME(6)                                                                            NOT NULL DEFAULT CURRENT_TIMESTAMP(6) COMMENT '状態変更日時',
+  app_sent_at     DATETIME(6)                                                                            NULL COMMENT 'ユーザーへの状態通知日時',
+  chair_sent_at   DATETIME(6)                                                                            NULL COMMENT '椅子への状態通知日時',
   PRIMARY KEY (id)
 )
   COMMENT = 'ライドステータスの変更履歴テーブル';
 
+DROP TABLE IF EXISTS ride_cancellations;
+CREATE TABLE ride_cancellations
+(
+  ride_id    VARCHAR(26) NOT NULL COMMENT 'ライドID',
+  fee        INTEGER     NOT NULL COMMENT 'キャンセル料',
+  created_at DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6) COMMENT 'キャンセル日時',
+  PRIMARY KEY (ride_id)
+)
+  COMMENT = 'ライドのキャンセルテーブル';
+
+DROP TABLE IF EXISTS ride_stops;
+CREATE TABLE ride_stops
+(
+  ride_id    VARCHAR(26) NOT NULL COMMENT 'ライドID',
+  stop_order INTEGER     NOT NULL COMMENT '経由する順番(1始まり)',
+  latitude   INTEGER     NOT NULL COMMENT '経由地の経度',
+  longitude  INTEGER     NOT NULL COMMENT '経由地の緯度',
+  arrived_at DATETIME(6) NULL COMMENT '経由地に到着した日時',
+  created_at DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6) COMMENT '登録日時',
+  PRIMARY KEY (ride_id, stop_order)
+)
+  COMMENT = 'ライドの経由地テーブル';
+
+DROP TABLE IF EXISTS ride_rejections;
+CREATE TABLE ride_rejections
+(
+  ride_id    VARCHAR(26) NOT NULL COMMENT 'ライドID',
+  chair_id   VARCHAR(26) NOT NULL COMMENT 'ライドを拒否した椅子ID',
+  created_at DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6) COMMENT '拒否日時',
+  PRIMARY KEY (ride_id, chair_id)
+)
+  COMMENT = '椅子によるライドの拒否テーブル';
+
 DROP TABLE IF EXISTS owners;
 CREATE TABLE owners
 (
@@ -134,3 +190,64 @@ CREATE TABLE coupons
   PRIMARY KEY (user_id, code)
 )
   COMMENT 'クーポンテーブル';
+
+DROP TABLE IF EXISTS coupon_campaigns;
+CREATE TABLE coupon_campaigns
+(
+  id                  VARCHAR(26)  NOT NULL COMMENT 'キャンペーンID',
+  kind                ENUM ('SIGNUP', 'INVITEE', 'INVITER', 'CODE') NOT NULL COMMENT 'クーポンを付与する契機',
+  code_prefix         VARCHAR(255) NOT NULL COMMENT 'クーポンコードの接頭辞',
+  discount_amount     INTEGER      NULL COMMENT '割引額',
+  discount_percentage INTEGER      NULL COMMENT '距離に応じた運賃に対する割引率(%)',
+  starts_at           DATETIME(6)  NULL COMMENT '開始日時',
+  ends_at             DATETIME(6)  NULL COMMENT '終了日時',
+  per_user_limit      INTEGER      NULL COMMENT '1ユーザーに付与できるクーポンの上限',
+  total_limit         INTEGER      NULL COMMENT '付与できるクーポンの総数の上限',
+  priority            INTEGER      NOT NULL DEFAULT 0 COMMENT 'ライドに適用する優先度。大きいものから使う',
+  created_at          DATETIME(6)  NOT NULL DEFAULT CURRENT_TIMESTAMP(6) COMMENT '作成日時',
+  PRIMARY KEY (id),
+  UNIQUE (code_prefix)
+)
+  COMMENT 'クーポンキャンペーンテーブル';
+
+DROP TABLE IF EXISTS payments;
+CREATE TABLE payments
+(
+  id              VARCHAR(26)                                         NOT NULL COMMENT '決済ID',
+  ride_id         VARCHAR(26)                                         NOT NULL COMMENT 'ライドID',
+  kind            ENUM ('RIDE', 'TIP')                                NOT NULL DEFAULT 'RIDE' COMMENT '決済の種類。RIDEは運賃もしくはキャンセル料、TIPはチップ',
+  idempotency_key VARCHAR(255)                                        NOT NULL COMMENT '決済マイクロサービスに送るIdempotency-Key',
+  amount          INTEGER                                             NOT NULL COMMENT '決済額',
+  status          ENUM ('PENDING', 'SUCCEEDED', 'FAILED', 'REJECTED') NOT NULL COMMENT '状態',
+  attempts        INTEGER                                             NOT NULL DEFAULT 0 COMMENT '決済マイクロサービスへのリクエスト回数',
+  last_error      TEXT                                                NULL COMMENT '最後に失敗した時のエラー',
+  next_attempt_at DATETIME(6)                                         NOT NULL DEFAULT CURRENT_TIMESTAMP(6) COMMENT '次に決済マイクロサービスへリクエストする日時',
+  created_at      DATETIME(6)                                         NOT NULL DEFAULT CURRENT_TIMESTAMP(6) COMMENT '登録日時',
+  updated_at      DATETIME(6)                                         NOT NULL DEFAULT CURRENT_TIMESTAMP(6) ON UPDATE CURRENT_TIMESTAMP(6) COMMENT '更新日時',
+  PRIMARY KEY (id),
+  UNIQUE (ride_id, kind),
+  UNIQUE (idempotency_key),
+  INDEX (status, next_attempt_at)
+)
+  COMMENT = '決済テーブル';
+
+DROP TABLE IF EXISTS payment_refunds;
+CREATE TABLE payment_refunds
+(
+  id              VARCHAR(26)                                         NOT NULL COMMENT '返金ID',
+  payment_id      VARCHAR(26)                                         NOT NULL COMMENT '返金する決済のID',
+  idempotency_key VARCHAR(255)                                        NOT NULL COMMENT '決済マイクロサービスに送るIdempotency-Key',
+  amount          INTEGER                                             NOT NULL COMMENT '返金額',
+  reason          TEXT                                                NULL COMMENT '返金の理由',
+  status          ENUM ('PENDING', 'SUCCEEDED', 'FAILED', 'REJECTED') NOT NULL COMMENT '状態',
+  attempts        INTEGER                                             NOT NULL DEFAULT 0 COMMENT '決済マイクロサービスへのリクエスト回数',
+  last_error      TEXT                                                NULL COMMENT '最後に失敗した時のエラー',
+  next_attempt_at DATETIME(6)                                         NOT NULL DEFAULT CURRENT_TIMESTAMP(6) COMMENT '次に決済マイクロサービスへリクエストする日時',
+  created_at      DATETIME(6)                                         NOT NULL DEFAULT CURRENT_TIMESTAMP(6) COMMENT '登録日時',
+  updated_at      DATETIME(6)                                         NOT NULL DEFAULT CURRENT_TIMESTAMP(6) ON UPDATE CURRENT_TIMESTAMP(6) COMMENT '更新日時',
+  PRIMARY KEY (id),
+  UNIQUE (idempotency_key),
+  INDEX (payment_id),
+  INDEX (status, next_attempt_at)
+)
+  COMMENT = '返金テーブル';
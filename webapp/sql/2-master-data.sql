@@ -62,3 +62,10 @@ VALUES ('リラックスシート NEO', 2),
        ('タイタンフレーム ULTRA', 7),
        ('ヴァーチェア SUPREME', 7),
        ('オブシディアン PRIME', 7);
+
+-- 登録時・招待時に付与するクーポンのキャンペーン
+-- 招待した側へのクーポンは1ユーザーにつき3枚までなので、1つの招待コードで招待できるのは3人まで
+INSERT INTO coupon_campaigns (id, kind, code_prefix, discount_amount, per_user_limit, priority)
+VALUES ('01JDFEDF000000000000000001', 'SIGNUP', 'CP_NEW2024', 3000, 1, 300),
+       ('01JDFEDF000000000000000002', 'INVITEE', 'INV_', 1500, 1, 200),
+       ('01JDFEDF000000000000000003', 'INVITER', 'RWD_', 1000, 3, 100);
@@ -0,0 +1,109 @@
+-- 初期データの投入後に適用するスキーマの変更
+-- 初期データはカラムを指定せずにINSERTしているので、既存のテーブルへのカラムの追加はここで行う
+
+ALTER TABLE rides
+  ADD COLUMN surge_rate INTEGER NOT NULL DEFAULT 100 COMMENT 'ライド作成時に確定したサージ倍率(%)' AFTER destination_longitude;
+
+-- 運賃の内訳はライドの作成時に確定させて保存する
+ALTER TABLE rides
+  ADD COLUMN base_fare    INTEGER    NOT NULL DEFAULT 0     COMMENT '初乗り運賃' AFTER surge_rate,
+  ADD COLUMN metered_fare INTEGER    NOT NULL DEFAULT 0     COMMENT '距離に応じた運賃(サージ倍率適用後)' AFTER base_fare,
+  ADD COLUMN discount     INTEGER    NOT NULL DEFAULT 0     COMMENT 'クーポンによる割引額' AFTER metered_fare,
+  ADD COLUMN fare         INTEGER    NOT NULL DEFAULT 0     COMMENT 'ユーザーが支払う運賃' AFTER discount,
+  ADD COLUMN currency     VARCHAR(3) NOT NULL DEFAULT 'JPY' COMMENT '通貨' AFTER fare;
+
+-- 初期データのライドの内訳を、座標とライドに紐づくクーポンから求める
+-- 更新日時はライドの完了日時として使われているので変えない
+UPDATE rides
+SET base_fare    = 500,
+    metered_fare = 100 * (ABS(pickup_latitude - destination_latitude) + ABS(pickup_longitude - destination_longitude)) * surge_rate DIV 100,
+    updated_at   = updated_at;
+
+UPDATE rides
+  INNER JOIN coupons ON coupons.used_by = rides.id
+SET rides.discount   = LEAST(coupons.discount, rides.metered_fare),
+    rides.updated_at = rides.updated_at;
+
+UPDATE rides
+SET fare       = base_fare + metered_fare - discount,
+    updated_at = updated_at;
+
+-- クーポンをキャンペーンに紐づける
+ALTER TABLE coupons
+  ADD COLUMN campaign_id VARCHAR(26) NULL COMMENT 'クーポンを付与したキャンペーンのID' AFTER code;
+
+UPDATE coupons
+SET campaign_id = CASE
+                    WHEN code = 'CP_NEW2024' THEN '01JDFEDF000000000000000001'
+                    WHEN code LIKE 'INV\_%' THEN '01JDFEDF000000000000000002'
+                    WHEN code LIKE 'RWD\_%' THEN '01JDFEDF000000000000000003'
+                  END;
+
+-- 予約したライドの配車日時
+ALTER TABLE rides
+  ADD COLUMN scheduled_at DATETIME(6) NULL COMMENT '予約された配車日時。予約でなければNULL' AFTER destination_longitude;
+
+-- 相乗りを希望したライド
+ALTER TABLE rides
+  ADD COLUMN is_pooled TINYINT(1) NOT NULL DEFAULT FALSE COMMENT '相乗りを希望したかどうか' AFTER scheduled_at;
+
+-- 引退した椅子はマッチングや周辺の椅子の検索から外し、売上などの履歴だけを残す
+ALTER TABLE chairs
+  ADD COLUMN retired_at DATETIME(6) NULL COMMENT '引退日時。引退していなければNULL' AFTER access_token;
+
+-- 椅子によるユーザーの評価
+ALTER TABLE rides
+  ADD COLUMN rider_evaluation INTEGER NULL COMMENT '椅子によるユーザーの評価' AFTER evaluation;
+
+-- ユーザーは決済トークンを複数登録し、そのうち1つをデフォルトにする
+-- 決済トークンIDはアプリケーションでULIDを生成して登録する
+-- 決済トークンIDを指定せずに登録する実装もあるので、指定されなければUUIDを振る
+ALTER TABLE payment_tokens
+  ADD COLUMN id         VARCHAR(36) NOT NULL DEFAULT (UUID()) COMMENT '決済トークンID' FIRST,
+  ADD COLUMN is_default TINYINT(1)  NOT NULL DEFAULT FALSE COMMENT 'デフォルトの決済トークンかどうか' AFTER token;
+
+-- 初期データの決済トークンはユーザーに1つずつなので、それぞれに新しいIDを振ってデフォルトにする
+UPDATE payment_tokens
+SET id         = UUID(),
+    is_default = TRUE;
+
+ALTER TABLE payment_tokens
+  DROP PRIMARY KEY,
+  ADD PRIMARY KEY (id),
+  ADD INDEX (user_id);
+
+-- ライドの支払いに使う決済トークン
+ALTER TABLE rides
+  ADD COLUMN payment_token_id VARCHAR(36) NULL COMMENT '支払いに使う決済トークンID。決まっていなければNULL' AFTER currency;
+
+UPDATE rides
+  INNER JOIN payment_tokens ON payment_tokens.user_id = rides.user_id
+SET rides.payment_token_id = payment_tokens.id,
+    rides.updated_at       = rides.updated_at;
+
+-- ライドの評価と一緒にユーザーが払うチップ
+ALTER TABLE rides
+  ADD COLUMN tip INTEGER NOT NULL DEFAULT 0 COMMENT 'チップ。運賃とは別に決済する' AFTER payment_token_id;
+
+-- 初期データの椅子の総移動距離と最後に記録した位置を、記録済みの位置情報から求めておく
+-- 初期データの位置情報は間引いていないので、前回の位置とのマンハッタン距離を足すだけで求まる
+INSERT INTO chair_distances (chair_id, total_distance, latitude, longitude, updated_at)
+SELECT totals.chair_id, totals.total_distance, latest.latitude, latest.longitude, latest.created_at
+FROM (SELECT chair_id,
+             SUM(IFNULL(distance, 0)) AS total_distance
+      FROM (SELECT chair_id,
+                   ABS(latitude - LAG(latitude) OVER (PARTITION BY chair_id ORDER BY created_at)) +
+                   ABS(longitude - LAG(longitude) OVER (PARTITION BY chair_id ORDER BY created_at)) AS distance
+            FROM chair_locations) tmp
+      GROUP BY chair_id) totals
+       INNER JOIN (SELECT chair_id,
+                          latitude,
+                          longitude,
+                          created_at,
+                          ROW_NUMBER() OVER (PARTITION BY chair_id ORDER BY created_at DESC) AS rn
+                   FROM chair_locations) latest ON latest.chair_id = totals.chair_id AND latest.rn = 1;
+
+-- 通知のポーラーが未通知の状態を探すためのインデックス
+ALTER TABLE ride_statuses
+  ADD INDEX (app_sent_at),
+  ADD INDEX (chair_sent_at);